@@ -12,6 +12,7 @@ require (
 	github.com/plutov/paypal v2.0.5+incompatible
 	github.com/vektah/gqlparser/v2 v2.0.1
 	go.mongodb.org/mongo-driver v1.3.4
+	golang.org/x/crypto v0.0.0-20191011191535-87dc89f01550
 	google.golang.org/api v0.26.0
 	google.golang.org/genproto v0.0.0-20200430143042-b979b6f78d84
 )
@@ -0,0 +1,28 @@
+package graph
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"github.com/eaemenkkstudios/cancanvas-backend/utils"
+)
+
+var roleLevels = map[model.Role]int{
+	model.RoleUser:      0,
+	model.RoleModerator: 1,
+	model.RoleAdmin:     2,
+}
+
+// HasRole directive
+func HasRole(ctx context.Context, obj interface{}, next graphql.Resolver, role model.Role) (interface{}, error) {
+	auth, err := utils.Authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if roleLevels[model.Role(auth.Role)] < roleLevels[role] {
+		return nil, errors.New("Forbidden")
+	}
+	return next(utils.WithAuth(ctx, auth))
+}
@@ -2,23 +2,76 @@
 
 package model
 
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type SearchResult interface {
+	IsSearchResult()
+}
+
 type Auction struct {
-	ID          string  `json:"id" bson:"_id,omitempty"`
-	Host        string  `json:"host"`
-	Description string  `json:"description"`
-	Offer       float64 `json:"offer"`
-	Bids        []*Bid  `json:"bids"`
-	Timestamp   string  `json:"timestamp"`
-	Deadline    string  `json:"deadline"`
+	ID           string        `json:"id" bson:"_id,omitempty"`
+	Host         string        `json:"host"`
+	Description  string        `json:"description"`
+	Offer        float64       `json:"offer"`
+	MinBudget    float64       `json:"minBudget"`
+	MaxBudget    float64       `json:"maxBudget"`
+	DeliveryDate string        `json:"deliveryDate"`
+	Tags         []string      `json:"tags"`
+	References   []string      `json:"references"`
+	Bids         []*Bid        `json:"bids"`
+	BidCount     int           `json:"bidCount"`
+	Timestamp    string        `json:"timestamp"`
+	Deadline     string        `json:"deadline"`
+	Status       AuctionStatus `json:"status"`
+}
+
+type AuctionFilter struct {
+	Near         *RegionFilter `json:"near"`
+	Query        *string       `json:"query"`
+	MinPrice     *float64      `json:"minPrice"`
+	MaxPrice     *float64      `json:"maxPrice"`
+	Tags         []string      `json:"tags"`
+	DeadlineFrom *string       `json:"deadlineFrom"`
+	DeadlineTo   *string       `json:"deadlineTo"`
+	MinBids      *int          `json:"minBids"`
+	MaxBids      *int          `json:"maxBids"`
+	NotBidByMe   *bool         `json:"notBidByMe"`
 }
 
 type Bid struct {
-	ID        string  `json:"id"`
-	Issuer    string  `json:"issuer"`
-	Deadline  string  `json:"deadline"`
-	Price     float64 `json:"price"`
-	Timestamp string  `json:"timestamp"`
-	Selected  bool    `json:"selected"`
+	ID           string         `json:"id"`
+	Issuer       string         `json:"issuer"`
+	Deadline     string         `json:"deadline"`
+	Price        float64        `json:"price"`
+	Timestamp    string         `json:"timestamp"`
+	Selected     bool           `json:"selected"`
+	Status       BidStatus      `json:"status"`
+	Revision     int            `json:"revision"`
+	CounterOffer *BidRevision   `json:"counterOffer"`
+	History      []*BidRevision `json:"history"`
+	Messages     []*BidMessage  `json:"messages"`
+}
+
+type BidMessage struct {
+	Author    string `json:"author"`
+	Message   string `json:"message"`
+	Timestamp string `json:"timestamp"`
+}
+
+type BidRevision struct {
+	Revision  int             `json:"revision"`
+	Type      BidRevisionType `json:"type"`
+	Author    string          `json:"author"`
+	Price     float64         `json:"price"`
+	Deadline  string          `json:"deadline"`
+	Message   *string         `json:"message"`
+	Timestamp string          `json:"timestamp"`
 }
 
 type Chat struct {
@@ -33,6 +86,8 @@ type Comment struct {
 	Text      string   `json:"text"`
 	LikeCount int      `json:"likeCount"`
 	Likes     []string `json:"likes"`
+	Hashtags  []string `json:"hashtags"`
+	Mentions  []string `json:"mentions"`
 	Timestamp string   `json:"timestamp"`
 }
 
@@ -41,14 +96,44 @@ type CommentList struct {
 	Count int        `json:"count"`
 }
 
+type EditAuction struct {
+	Description      *string           `json:"description"`
+	MinBudget        *float64          `json:"minBudget"`
+	MaxBudget        *float64          `json:"maxBudget"`
+	Deadline         *string           `json:"deadline"`
+	DeliveryDate     *string           `json:"deliveryDate"`
+	Tags             []string          `json:"tags"`
+	AddReferences    []*graphql.Upload `json:"addReferences"`
+	RemoveReferences []string          `json:"removeReferences"`
+}
+
 type FeedAuction struct {
-	ID          string    `json:"id"`
-	Host        *FeedUser `json:"host"`
-	Description string    `json:"description"`
-	Offer       float64   `json:"offer"`
-	Bids        []*Bid    `json:"bids"`
-	Timestamp   string    `json:"timestamp"`
-	Deadline    string    `json:"deadline"`
+	ID           string        `json:"id"`
+	Host         *FeedUser     `json:"host"`
+	Description  string        `json:"description"`
+	Offer        float64       `json:"offer"`
+	MinBudget    float64       `json:"minBudget"`
+	MaxBudget    float64       `json:"maxBudget"`
+	DeliveryDate string        `json:"deliveryDate"`
+	Tags         []string      `json:"tags"`
+	References   []string      `json:"references"`
+	Bids         []*Bid        `json:"bids"`
+	BidCount     int           `json:"bidCount"`
+	Timestamp    string        `json:"timestamp"`
+	Deadline     string        `json:"deadline"`
+	Status       AuctionStatus `json:"status"`
+}
+
+func (FeedAuction) IsSearchResult() {}
+
+type FeedAuctionConnection struct {
+	Edges    []*FeedAuctionEdge `json:"edges"`
+	PageInfo *PageInfo          `json:"pageInfo"`
+}
+
+type FeedAuctionEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *FeedAuction `json:"node"`
 }
 
 type FeedPost struct {
@@ -60,18 +145,54 @@ type FeedPost struct {
 	Comments    *CommentList `json:"comments"`
 	Likes       int          `json:"likes"`
 	Liked       bool         `json:"liked"`
+	Hashtags    []string     `json:"hashtags"`
+	Mentions    []string     `json:"mentions"`
 	BidID       *string      `json:"bidID"`
 }
 
+func (FeedPost) IsSearchResult() {}
+
+type FeedPostConnection struct {
+	Edges    []*FeedPostEdge `json:"edges"`
+	PageInfo *PageInfo       `json:"pageInfo"`
+}
+
+type FeedPostEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *FeedPost `json:"node"`
+}
+
 type FeedUser struct {
 	Nickname string `json:"nickname"`
 	Name     string `json:"name"`
 	Picture  string `json:"picture"`
 }
 
+type FeedUserConnection struct {
+	Edges    []*FeedUserEdge `json:"edges"`
+	PageInfo *PageInfo       `json:"pageInfo"`
+}
+
+type FeedUserEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *FeedUser `json:"node"`
+}
+
 type Login struct {
-	Token string `json:"token"`
-	First bool   `json:"first"`
+	Token             *string `json:"token"`
+	RefreshToken      *string `json:"refreshToken"`
+	First             bool    `json:"first"`
+	TwoFactorRequired bool    `json:"twoFactorRequired"`
+	NicknameRequired  bool    `json:"nicknameRequired"`
+	Challenge         *string `json:"challenge"`
+}
+
+type MailQueueStats struct {
+	Pending       int     `json:"pending"`
+	Sending       int     `json:"sending"`
+	Sent          int     `json:"sent"`
+	Dead          int     `json:"dead"`
+	OldestPending *string `json:"oldestPending"`
 }
 
 type Message struct {
@@ -81,11 +202,47 @@ type Message struct {
 	Sender    string `json:"sender"`
 }
 
+type NearbyUser struct {
+	User       *User   `json:"user"`
+	DistanceKm float64 `json:"distanceKm"`
+}
+
+type NewAuction struct {
+	Description  string            `json:"description"`
+	MinBudget    float64           `json:"minBudget"`
+	MaxBudget    float64           `json:"maxBudget"`
+	Deadline     *string           `json:"deadline"`
+	DeliveryDate string            `json:"deliveryDate"`
+	Tags         []string          `json:"tags"`
+	References   []*graphql.Upload `json:"references"`
+}
+
 type NewUser struct {
-	Nickname string `json:"nickname"`
-	Name     string `json:"name"`
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Nickname string  `json:"nickname"`
+	Name     string  `json:"name"`
+	Email    string  `json:"email"`
+	Password string  `json:"password"`
+	Locale   *string `json:"locale"`
+}
+
+type Notification struct {
+	ID        string           `json:"id"`
+	Type      NotificationType `json:"type"`
+	Actor     *FeedUser        `json:"actor"`
+	PostID    string           `json:"postID"`
+	CommentID *string          `json:"commentID"`
+	Read      bool             `json:"read"`
+	Timestamp string           `json:"timestamp"`
+}
+
+type NotificationConnection struct {
+	Edges    []*NotificationEdge `json:"edges"`
+	PageInfo *PageInfo           `json:"pageInfo"`
+}
+
+type NotificationEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *Notification `json:"node"`
 }
 
 type Order struct {
@@ -98,6 +255,13 @@ type Order struct {
 	Status     string  `json:"status"`
 }
 
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
 type Post struct {
 	ID          string       `json:"id" bson:"_id,omitempty"`
 	Author      string       `json:"author"`
@@ -106,30 +270,521 @@ type Post struct {
 	Timestamp   string       `json:"timestamp"`
 	Comments    *CommentList `json:"comments"`
 	LikeCount   int          `json:"likeCount"`
-	Likes       []string     `json:"likes"`
 	Liked       bool         `json:"liked" bson:"liked,omitempty"`
+	Hashtags    []string     `json:"hashtags"`
+	Mentions    []string     `json:"mentions"`
 	BidID       *string      `json:"bidID"`
 }
 
 type PostComment struct {
-	ID        string    `json:"id"`
-	Author    *FeedUser `json:"author"`
-	Text      string    `json:"text"`
-	Likes     int       `json:"likes"`
-	Liked     bool      `json:"liked"`
-	Timestamp string    `json:"timestamp"`
+	ID         string    `json:"id"`
+	PostID     string    `json:"postID"`
+	ParentID   *string   `json:"parentID"`
+	Author     *FeedUser `json:"author"`
+	Text       string    `json:"text"`
+	Likes      int       `json:"likes"`
+	Liked      bool      `json:"liked"`
+	Mentions   []string  `json:"mentions"`
+	ReplyCount int       `json:"replyCount"`
+	Timestamp  string    `json:"timestamp"`
+	EditedAt   *string   `json:"editedAt"`
+}
+
+type PostCommentConnection struct {
+	Edges    []*PostCommentEdge `json:"edges"`
+	PageInfo *PageInfo          `json:"pageInfo"`
+}
+
+type PostCommentEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *PostComment `json:"node"`
+}
+
+type PostConnection struct {
+	Edges    []*PostEdge `json:"edges"`
+	PageInfo *PageInfo   `json:"pageInfo"`
+}
+
+type PostEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Post  `json:"node"`
+}
+
+type RegionFilter struct {
+	Lat      float64 `json:"lat"`
+	Lng      float64 `json:"lng"`
+	RadiusKm float64 `json:"radiusKm"`
+}
+
+type SearchHit struct {
+	Score  float64      `json:"score"`
+	Result SearchResult `json:"result"`
+}
+
+type SearchHitConnection struct {
+	Edges    []*SearchHitEdge `json:"edges"`
+	PageInfo *PageInfo        `json:"pageInfo"`
+}
+
+type SearchHitEdge struct {
+	Cursor string     `json:"cursor"`
+	Node   *SearchHit `json:"node"`
+}
+
+type SuggestionReason struct {
+	Code   SuggestionReasonCode `json:"code"`
+	Detail *string              `json:"detail"`
+}
+
+type Tag struct {
+	Name  string `json:"name"`
+	Users int    `json:"users"`
+}
+
+func (Tag) IsSearchResult() {}
+
+type TwoFactorSetup struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
 }
 
 type User struct {
-	Nickname       string   `json:"nickname"`
-	Name           string   `json:"name"`
-	Email          string   `json:"email"`
-	Bio            string   `json:"bio"`
-	Picture        string   `json:"picture"`
-	Cover          string   `json:"cover"`
-	Followers      []string `json:"followers"`
-	FollowersCount int      `json:"followersCount"`
-	Following      []string `json:"following"`
-	Lat            float64  `json:"lat"`
-	Lng            float64  `json:"lng"`
+	Nickname        string          `json:"nickname"`
+	Name            string          `json:"name"`
+	Email           string          `json:"email"`
+	EmailVerified   bool            `json:"emailVerified"`
+	Bio             string          `json:"bio"`
+	Picture         string          `json:"picture"`
+	Cover           string          `json:"cover"`
+	Followers       []string        `json:"followers"`
+	FollowersCount  int             `json:"followersCount"`
+	Following       []string        `json:"following"`
+	Lat             *float64        `json:"lat"`
+	Lng             *float64        `json:"lng"`
+	LocationPrivacy LocationPrivacy `json:"locationPrivacy"`
+}
+
+func (User) IsSearchResult() {}
+
+type UserConnection struct {
+	Edges    []*UserEdge `json:"edges"`
+	PageInfo *PageInfo   `json:"pageInfo"`
+}
+
+type UserEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *User  `json:"node"`
+}
+
+type UserSuggestion struct {
+	User    *FeedUser           `json:"user"`
+	Score   float64             `json:"score"`
+	Reasons []*SuggestionReason `json:"reasons"`
+}
+
+type AuctionSort string
+
+const (
+	AuctionSortNewest       AuctionSort = "NEWEST"
+	AuctionSortHighestOffer AuctionSort = "HIGHEST_OFFER"
+	AuctionSortLowestOffer  AuctionSort = "LOWEST_OFFER"
+	AuctionSortEndingSoon   AuctionSort = "ENDING_SOON"
+	AuctionSortMostBids     AuctionSort = "MOST_BIDS"
+	AuctionSortFewestBids   AuctionSort = "FEWEST_BIDS"
+)
+
+var AllAuctionSort = []AuctionSort{
+	AuctionSortNewest,
+	AuctionSortHighestOffer,
+	AuctionSortLowestOffer,
+	AuctionSortEndingSoon,
+	AuctionSortMostBids,
+	AuctionSortFewestBids,
+}
+
+func (e AuctionSort) IsValid() bool {
+	switch e {
+	case AuctionSortNewest, AuctionSortHighestOffer, AuctionSortLowestOffer, AuctionSortEndingSoon, AuctionSortMostBids, AuctionSortFewestBids:
+		return true
+	}
+	return false
+}
+
+func (e AuctionSort) String() string {
+	return string(e)
+}
+
+func (e *AuctionSort) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuctionSort(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuctionSort", str)
+	}
+	return nil
+}
+
+func (e AuctionSort) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type AuctionStatus string
+
+const (
+	AuctionStatusOpen       AuctionStatus = "OPEN"
+	AuctionStatusClosed     AuctionStatus = "CLOSED"
+	AuctionStatusAwarded    AuctionStatus = "AWARDED"
+	AuctionStatusInProgress AuctionStatus = "IN_PROGRESS"
+	AuctionStatusDelivered  AuctionStatus = "DELIVERED"
+	AuctionStatusCompleted  AuctionStatus = "COMPLETED"
+	AuctionStatusCancelled  AuctionStatus = "CANCELLED"
+	AuctionStatusDisputed   AuctionStatus = "DISPUTED"
+)
+
+var AllAuctionStatus = []AuctionStatus{
+	AuctionStatusOpen,
+	AuctionStatusClosed,
+	AuctionStatusAwarded,
+	AuctionStatusInProgress,
+	AuctionStatusDelivered,
+	AuctionStatusCompleted,
+	AuctionStatusCancelled,
+	AuctionStatusDisputed,
+}
+
+func (e AuctionStatus) IsValid() bool {
+	switch e {
+	case AuctionStatusOpen, AuctionStatusClosed, AuctionStatusAwarded, AuctionStatusInProgress, AuctionStatusDelivered, AuctionStatusCompleted, AuctionStatusCancelled, AuctionStatusDisputed:
+		return true
+	}
+	return false
+}
+
+func (e AuctionStatus) String() string {
+	return string(e)
+}
+
+func (e *AuctionStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuctionStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuctionStatus", str)
+	}
+	return nil
+}
+
+func (e AuctionStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type BidRevisionType string
+
+const (
+	BidRevisionTypeBid             BidRevisionType = "BID"
+	BidRevisionTypeRevision        BidRevisionType = "REVISION"
+	BidRevisionTypeCounterOffer    BidRevisionType = "COUNTER_OFFER"
+	BidRevisionTypeCounterAccepted BidRevisionType = "COUNTER_ACCEPTED"
+)
+
+var AllBidRevisionType = []BidRevisionType{
+	BidRevisionTypeBid,
+	BidRevisionTypeRevision,
+	BidRevisionTypeCounterOffer,
+	BidRevisionTypeCounterAccepted,
+}
+
+func (e BidRevisionType) IsValid() bool {
+	switch e {
+	case BidRevisionTypeBid, BidRevisionTypeRevision, BidRevisionTypeCounterOffer, BidRevisionTypeCounterAccepted:
+		return true
+	}
+	return false
+}
+
+func (e BidRevisionType) String() string {
+	return string(e)
+}
+
+func (e *BidRevisionType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BidRevisionType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BidRevisionType", str)
+	}
+	return nil
+}
+
+func (e BidRevisionType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type BidStatus string
+
+const (
+	BidStatusPending  BidStatus = "PENDING"
+	BidStatusAccepted BidStatus = "ACCEPTED"
+	BidStatusRejected BidStatus = "REJECTED"
+)
+
+var AllBidStatus = []BidStatus{
+	BidStatusPending,
+	BidStatusAccepted,
+	BidStatusRejected,
+}
+
+func (e BidStatus) IsValid() bool {
+	switch e {
+	case BidStatusPending, BidStatusAccepted, BidStatusRejected:
+		return true
+	}
+	return false
+}
+
+func (e BidStatus) String() string {
+	return string(e)
+}
+
+func (e *BidStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BidStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BidStatus", str)
+	}
+	return nil
+}
+
+func (e BidStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type LocationPrivacy string
+
+const (
+	LocationPrivacyExact       LocationPrivacy = "EXACT"
+	LocationPrivacyApproximate LocationPrivacy = "APPROXIMATE"
+	LocationPrivacyHidden      LocationPrivacy = "HIDDEN"
+)
+
+var AllLocationPrivacy = []LocationPrivacy{
+	LocationPrivacyExact,
+	LocationPrivacyApproximate,
+	LocationPrivacyHidden,
+}
+
+func (e LocationPrivacy) IsValid() bool {
+	switch e {
+	case LocationPrivacyExact, LocationPrivacyApproximate, LocationPrivacyHidden:
+		return true
+	}
+	return false
+}
+
+func (e LocationPrivacy) String() string {
+	return string(e)
+}
+
+func (e *LocationPrivacy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LocationPrivacy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LocationPrivacy", str)
+	}
+	return nil
+}
+
+func (e LocationPrivacy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type NotificationType string
+
+const (
+	NotificationTypeMention NotificationType = "MENTION"
+)
+
+var AllNotificationType = []NotificationType{
+	NotificationTypeMention,
+}
+
+func (e NotificationType) IsValid() bool {
+	switch e {
+	case NotificationTypeMention:
+		return true
+	}
+	return false
+}
+
+func (e NotificationType) String() string {
+	return string(e)
+}
+
+func (e *NotificationType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationType", str)
+	}
+	return nil
+}
+
+func (e NotificationType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type Role string
+
+const (
+	RoleUser      Role = "USER"
+	RoleModerator Role = "MODERATOR"
+	RoleAdmin     Role = "ADMIN"
+)
+
+var AllRole = []Role{
+	RoleUser,
+	RoleModerator,
+	RoleAdmin,
+}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleUser, RoleModerator, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SearchType string
+
+const (
+	SearchTypeUser    SearchType = "USER"
+	SearchTypePost    SearchType = "POST"
+	SearchTypeAuction SearchType = "AUCTION"
+	SearchTypeTag     SearchType = "TAG"
+)
+
+var AllSearchType = []SearchType{
+	SearchTypeUser,
+	SearchTypePost,
+	SearchTypeAuction,
+	SearchTypeTag,
+}
+
+func (e SearchType) IsValid() bool {
+	switch e {
+	case SearchTypeUser, SearchTypePost, SearchTypeAuction, SearchTypeTag:
+		return true
+	}
+	return false
+}
+
+func (e SearchType) String() string {
+	return string(e)
+}
+
+func (e *SearchType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SearchType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SearchType", str)
+	}
+	return nil
+}
+
+func (e SearchType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SuggestionReasonCode string
+
+const (
+	SuggestionReasonCodeFollowsYou SuggestionReasonCode = "FOLLOWS_YOU"
+	SuggestionReasonCodeFollowedBy SuggestionReasonCode = "FOLLOWED_BY"
+	SuggestionReasonCodeSharesTag  SuggestionReasonCode = "SHARES_TAG"
+	SuggestionReasonCodeNearby     SuggestionReasonCode = "NEARBY"
+	SuggestionReasonCodeActive     SuggestionReasonCode = "ACTIVE"
+)
+
+var AllSuggestionReasonCode = []SuggestionReasonCode{
+	SuggestionReasonCodeFollowsYou,
+	SuggestionReasonCodeFollowedBy,
+	SuggestionReasonCodeSharesTag,
+	SuggestionReasonCodeNearby,
+	SuggestionReasonCodeActive,
+}
+
+func (e SuggestionReasonCode) IsValid() bool {
+	switch e {
+	case SuggestionReasonCodeFollowsYou, SuggestionReasonCodeFollowedBy, SuggestionReasonCodeSharesTag, SuggestionReasonCodeNearby, SuggestionReasonCodeActive:
+		return true
+	}
+	return false
+}
+
+func (e SuggestionReasonCode) String() string {
+	return string(e)
+}
+
+func (e *SuggestionReasonCode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SuggestionReasonCode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SuggestionReasonCode", str)
+	}
+	return nil
+}
+
+func (e SuggestionReasonCode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
 }
@@ -21,265 +21,389 @@ var feedRepository = repository.NewFeedRepository()
 var tagsRepository = repository.NewTagsRepository()
 var orderRepository = repository.NewOrderRepository()
 var auctionRepository = repository.NewAuctionRepository()
+var sessionRepository = repository.NewSessionRepository()
+var mailOutbox = repository.NewMailOutbox()
+var searchRepository = repository.NewSearchRepository()
+var notificationRepository = repository.NewNotificationRepository()
 
 func (r *mutationResolver) CreateUser(ctx context.Context, input model.NewUser) (*model.User, error) {
 	return userRepository.CreateUser(&input)
 }
 
 func (r *mutationResolver) UpdateUserPicture(ctx context.Context, picture graphql.Upload) (string, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return "", err
-	}
+	sender := utils.GetSender(ctx)
 	return userRepository.UpdateProfilePicture(sender, picture)
 }
 
 func (r *mutationResolver) UpdateUserLocation(ctx context.Context, lat float64, lng float64) (bool, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return false, err
-	}
+	sender := utils.GetSender(ctx)
 	return userRepository.UpdateLocation(sender, lat, lng)
 }
 
+func (r *mutationResolver) UpdateLocationPrivacy(ctx context.Context, privacy model.LocationPrivacy) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return userRepository.UpdateLocationPrivacy(sender, privacy)
+}
+
 func (r *mutationResolver) UpdateUserBio(ctx context.Context, bio string) (bool, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return false, err
-	}
+	sender := utils.GetSender(ctx)
 	return userRepository.UpdateBio(sender, bio)
 }
 
 func (r *mutationResolver) UpdateUserCover(ctx context.Context, cover graphql.Upload) (string, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return "", err
-	}
+	sender := utils.GetSender(ctx)
 	return userRepository.UpdateCover(sender, cover)
 }
 
 func (r *mutationResolver) UpdateUserTags(ctx context.Context, tags []string) (bool, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return true, err
-	}
+	sender := utils.GetSender(ctx)
 	return tagsRepository.UpdateUserTags(sender, tags)
 }
 
 func (r *mutationResolver) AddTagToUser(ctx context.Context, tag string) (bool, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return false, err
-	}
+	sender := utils.GetSender(ctx)
 	return tagsRepository.AddTagToUser(sender, tag)
 }
 
 func (r *mutationResolver) RemoveTagFromUser(ctx context.Context, tag string) (bool, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return false, err
-	}
+	sender := utils.GetSender(ctx)
 	return tagsRepository.RemoveTagFromUser(sender, tag)
 }
 
 func (r *mutationResolver) Follow(ctx context.Context, nickname string) (bool, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return false, err
-	}
+	sender := utils.GetSender(ctx)
 	return userRepository.Follow(sender, nickname)
 }
 
 func (r *mutationResolver) Unfollow(ctx context.Context, nickname string) (bool, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return false, err
-	}
+	sender := utils.GetSender(ctx)
 	return userRepository.Unfollow(sender, nickname)
 }
 
 func (r *mutationResolver) SendMessage(ctx context.Context, msg string, receiver string) (bool, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return false, err
-	}
+	sender := utils.GetSender(ctx)
 	return chatRepository.SendMessage(sender, msg, receiver)
 }
 
 func (r *mutationResolver) SendMessageToDialogflow(ctx context.Context, msg string) (string, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return "", err
-	}
+	sender := utils.GetSender(ctx)
 	return chatRepository.SendMessageToDialogflow(sender, msg)
 }
 
 func (r *mutationResolver) CreatePost(ctx context.Context, content graphql.Upload, description *string, bidID *string) (string, error) {
-	author, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return "", err
-	}
+	author := utils.GetSender(ctx)
 	return postRepository.CreatePost(author, content, description, bidID)
 }
 
 func (r *mutationResolver) EditPost(ctx context.Context, postID string, description string) (bool, error) {
-	author, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return false, err
-	}
+	author := utils.GetSender(ctx)
 	return postRepository.EditPost(author, postID, description)
 }
 
 func (r *mutationResolver) DeletePost(ctx context.Context, postID string) (bool, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return false, err
-	}
+	sender := utils.GetSender(ctx)
 	return postRepository.DeletePost(sender, postID)
 }
 
 func (r *mutationResolver) LikeComment(ctx context.Context, postID string, commentID string) (bool, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return false, err
-	}
+	sender := utils.GetSender(ctx)
 	return postRepository.LikeComment(sender, postID, commentID)
 }
 
+func (r *mutationResolver) UnlikeComment(ctx context.Context, postID string, commentID string) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return postRepository.UnlikeComment(sender, postID, commentID)
+}
+
 func (r *mutationResolver) LikePost(ctx context.Context, postID string) (bool, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return false, err
-	}
+	sender := utils.GetSender(ctx)
 	return postRepository.LikePost(sender, postID)
 }
 
-func (r *mutationResolver) CommentOnPost(ctx context.Context, postID string, message string) (string, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return "", err
-	}
-	return postRepository.CommentOnPost(sender, postID, message)
+func (r *mutationResolver) UnlikePost(ctx context.Context, postID string) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return postRepository.UnlikePost(sender, postID)
+}
+
+func (r *mutationResolver) CommentOnPost(ctx context.Context, postID string, message string, parentID *string) (string, error) {
+	sender := utils.GetSender(ctx)
+	return postRepository.CommentOnPost(sender, postID, message, parentID)
 }
 
 func (r *mutationResolver) EditComment(ctx context.Context, postID string, commentID string, message string) (bool, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return false, err
-	}
+	sender := utils.GetSender(ctx)
 	return postRepository.EditComment(sender, postID, commentID, message)
 }
 
 func (r *mutationResolver) DeleteComment(ctx context.Context, postID string, commentID string) (bool, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return false, err
-	}
+	sender := utils.GetSender(ctx)
 	return postRepository.DeleteComment(sender, postID, commentID)
 }
 
-func (r *mutationResolver) CreateAuction(ctx context.Context, offer float64, description string) (*model.Auction, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return auctionRepository.CreateAuction(sender, description, offer)
+func (r *mutationResolver) CreateAuction(ctx context.Context, input model.NewAuction) (*model.Auction, error) {
+	sender := utils.GetSender(ctx)
+	return auctionRepository.CreateAuction(sender, input)
+}
+
+func (r *mutationResolver) EditAuction(ctx context.Context, auctionID string, input model.EditAuction) (*model.Auction, error) {
+	sender := utils.GetSender(ctx)
+	return auctionRepository.EditAuction(sender, auctionID, input)
 }
 
 func (r *mutationResolver) DeleteAuction(ctx context.Context, auctionID string) (bool, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return false, err
-	}
+	sender := utils.GetSender(ctx)
 	return auctionRepository.DeleteAuction(sender, auctionID)
 }
 
+func (r *mutationResolver) CloseAuction(ctx context.Context, auctionID string) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return auctionRepository.CloseAuction(sender, auctionID)
+}
+
+func (r *mutationResolver) CancelAuction(ctx context.Context, auctionID string) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return auctionRepository.CancelAuction(sender, auctionID)
+}
+
+func (r *mutationResolver) MarkAuctionDelivered(ctx context.Context, auctionID string) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return auctionRepository.MarkAuctionDelivered(sender, auctionID)
+}
+
+func (r *mutationResolver) CompleteAuction(ctx context.Context, auctionID string) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return auctionRepository.CompleteAuction(sender, auctionID)
+}
+
+func (r *mutationResolver) DisputeAuction(ctx context.Context, auctionID string) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return auctionRepository.DisputeAuction(sender, auctionID)
+}
+
 func (r *mutationResolver) CreateBid(ctx context.Context, auctionID string, deadline string, price float64) (*model.Bid, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return nil, err
-	}
+	sender := utils.GetSender(ctx)
 	return auctionRepository.CreateBid(sender, auctionID, deadline, price)
 }
 
 func (r *mutationResolver) DeleteBid(ctx context.Context, auctionID string, bidID string) (bool, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return false, err
-	}
+	sender := utils.GetSender(ctx)
 	return auctionRepository.DeleteBid(sender, auctionID, bidID)
 }
 
-func (r *mutationResolver) AcceptBid(ctx context.Context, auctionID string, bidID string) (bool, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return false, err
-	}
-	return auctionRepository.AcceptBid(sender, auctionID, bidID)
+func (r *mutationResolver) AcceptBid(ctx context.Context, auctionID string, bidID string, revision *int) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return auctionRepository.AcceptBid(sender, auctionID, bidID, revision)
+}
+
+func (r *mutationResolver) ReviseBid(ctx context.Context, auctionID string, bidID string, price *float64, deadline *string, message *string) (*model.Bid, error) {
+	sender := utils.GetSender(ctx)
+	return auctionRepository.ReviseBid(sender, auctionID, bidID, price, deadline, message)
+}
+
+func (r *mutationResolver) CounterOfferBid(ctx context.Context, auctionID string, bidID string, price *float64, deadline *string, message *string) (*model.Bid, error) {
+	sender := utils.GetSender(ctx)
+	return auctionRepository.CounterOfferBid(sender, auctionID, bidID, price, deadline, message)
+}
+
+func (r *mutationResolver) AcceptCounterOffer(ctx context.Context, auctionID string, bidID string) (*model.Bid, error) {
+	sender := utils.GetSender(ctx)
+	return auctionRepository.AcceptCounterOffer(sender, auctionID, bidID)
+}
+
+func (r *mutationResolver) SendBidMessage(ctx context.Context, auctionID string, bidID string, message string) (*model.BidMessage, error) {
+	sender := utils.GetSender(ctx)
+	return auctionRepository.SendBidMessage(sender, auctionID, bidID, message)
+}
+
+func (r *mutationResolver) UnacceptBid(ctx context.Context, auctionID string, bidID string) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return auctionRepository.UnacceptBid(sender, auctionID, bidID)
 }
 
 func (r *mutationResolver) SendForgotPasswordEmail(ctx context.Context, nickname string) (bool, error) {
-	return authRepository.SendForgotPasswordEmail(nickname)
+	return authRepository.SendForgotPasswordEmail(ctx, nickname)
 }
 
 func (r *mutationResolver) ResetPassword(ctx context.Context, token string, newPassword string) (bool, error) {
-	sender, hash, err := utils.GetSenderAndHashFromToken(token)
-	if err != nil {
-		return false, err
-	}
-	return authRepository.ResetPassword(sender, hash, newPassword)
+	return authRepository.ResetPassword(token, newPassword)
+}
+
+func (r *mutationResolver) ResendVerificationEmail(ctx context.Context) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return userRepository.ResendVerificationEmail(sender)
+}
+
+func (r *mutationResolver) ChangeEmail(ctx context.Context, email string) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return userRepository.ChangeEmail(sender, email)
+}
+
+func (r *mutationResolver) UpdateUserLocale(ctx context.Context, locale string) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return userRepository.UpdateLocale(sender, locale)
+}
+
+func (r *mutationResolver) MarkPostsSeen(ctx context.Context, postIDs []string) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return feedRepository.MarkPostsSeen(sender, postIDs)
+}
+
+func (r *mutationResolver) MarkNotificationsRead(ctx context.Context, notificationIDs []string) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return notificationRepository.MarkRead(sender, notificationIDs)
+}
+
+func (r *mutationResolver) BlockUser(ctx context.Context, nickname string) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return userRepository.Block(sender, nickname)
+}
+
+func (r *mutationResolver) UnblockUser(ctx context.Context, nickname string) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return userRepository.Unblock(sender, nickname)
+}
+
+func (r *mutationResolver) EnableTwoFactor(ctx context.Context) (*model.TwoFactorSetup, error) {
+	sender := utils.GetSender(ctx)
+	return authRepository.EnableTwoFactor(sender)
+}
+
+func (r *mutationResolver) ConfirmTwoFactor(ctx context.Context, code string) ([]string, error) {
+	sender := utils.GetSender(ctx)
+	return authRepository.ConfirmTwoFactor(sender, code)
 }
 
-func (r *queryResolver) Users(ctx context.Context, nickname *string, page *int) ([]*model.User, error) {
-	_, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return userRepository.FindAll(nickname, page)
+func (r *mutationResolver) DisableTwoFactor(ctx context.Context, code string) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return authRepository.DisableTwoFactor(sender, code)
+}
+
+func (r *mutationResolver) VerifyTwoFactor(ctx context.Context, challenge string, code string) (*model.Login, error) {
+	return authRepository.VerifyTwoFactor(ctx, challenge, code)
+}
+
+func (r *mutationResolver) LoginWithProvider(ctx context.Context, provider string, code string, nonce string) (*model.Login, error) {
+	return authRepository.LoginWithProvider(provider, code, nonce)
+}
+
+func (r *mutationResolver) CompleteProviderSignup(ctx context.Context, token string, nickname string) (*model.Login, error) {
+	return authRepository.CompleteProviderSignup(token, nickname)
+}
+
+func (r *mutationResolver) LinkProvider(ctx context.Context, provider string, code string, nonce string) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return authRepository.LinkProvider(sender, provider, code, nonce)
+}
+
+func (r *mutationResolver) RefreshToken(ctx context.Context, refreshToken string) (*model.Login, error) {
+	return sessionRepository.RefreshSession(refreshToken)
+}
+
+func (r *mutationResolver) Logout(ctx context.Context) (bool, error) {
+	auth := utils.GetAuth(ctx)
+	return sessionRepository.RevokeSession(auth.Sender, auth.SessionID)
+}
+
+func (r *mutationResolver) LogoutAllDevices(ctx context.Context) (bool, error) {
+	sender := utils.GetSender(ctx)
+	return sessionRepository.RevokeAllSessions(sender)
+}
+
+func (r *mutationResolver) RemovePost(ctx context.Context, postID string) (bool, error) {
+	return postRepository.RemovePost(postID)
+}
+
+func (r *mutationResolver) RemoveComment(ctx context.Context, postID string, commentID string) (bool, error) {
+	return postRepository.RemoveComment(postID, commentID)
+}
+
+func (r *mutationResolver) RemoveAuction(ctx context.Context, auctionID string) (bool, error) {
+	return auctionRepository.RemoveAuction(auctionID)
+}
+
+func (r *mutationResolver) ResolveAuctionDispute(ctx context.Context, auctionID string, status model.AuctionStatus) (bool, error) {
+	return auctionRepository.ResolveAuctionDispute(auctionID, status)
+}
+
+func (r *mutationResolver) UpdateUserRole(ctx context.Context, nickname string, role model.Role) (bool, error) {
+	return userRepository.UpdateRole(nickname, role)
+}
+
+func (r *queryResolver) Users(ctx context.Context, nickname *string, first *int, after *string) (*model.UserConnection, error) {
+	return userRepository.FindAll(nickname, first, after)
 }
 
 func (r *queryResolver) Self(ctx context.Context) (*model.User, error) {
-	nickname, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return userRepository.FindOne(nickname)
+	nickname := utils.GetSender(ctx)
+	return userRepository.FindOne(nickname, nickname)
+}
+
+func (r *queryResolver) Feed(ctx context.Context, first *int, after *string) (*model.FeedPostConnection, error) {
+	nickname := utils.GetSender(ctx)
+	return feedRepository.GetFeed(nickname, first, after)
+}
+
+func (r *queryResolver) Trending(ctx context.Context, first *int, after *string, region *model.RegionFilter) (*model.FeedPostConnection, error) {
+	nickname := utils.GetSender(ctx)
+	return feedRepository.GetTrending(nickname, nil, region, first, after)
+}
+
+func (r *queryResolver) UsersNear(ctx context.Context, lat float64, lng float64, radiusKm float64, tags []string, first *int) ([]*model.NearbyUser, error) {
+	sender := utils.GetSender(ctx)
+	return userRepository.FindNear(sender, lat, lng, radiusKm, tags, first)
 }
 
-func (r *queryResolver) Feed(ctx context.Context, page *int) ([]*model.FeedPost, error) {
-	nickname, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return feedRepository.GetFeed(nickname, page)
+func (r *queryResolver) SuggestedUsers(ctx context.Context, first *int) ([]*model.UserSuggestion, error) {
+	sender := utils.GetSender(ctx)
+	return userRepository.SuggestUsers(sender, first)
 }
 
-func (r *queryResolver) Trending(ctx context.Context, page *int) ([]*model.FeedPost, error) {
-	nickname, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return feedRepository.GetTrending(nickname, page)
+func (r *queryResolver) RecommendedFeed(ctx context.Context, first *int, after *string) (*model.FeedPostConnection, error) {
+	nickname := utils.GetSender(ctx)
+	return feedRepository.GetRecommended(nickname, first, after)
+}
+
+func (r *queryResolver) PostsByHashtag(ctx context.Context, hashtag string, first *int, after *string) (*model.FeedPostConnection, error) {
+	sender := utils.GetSender(ctx)
+	return feedRepository.GetPostsByHashtag(sender, hashtag, first, after)
+}
+
+func (r *queryResolver) Notifications(ctx context.Context, unreadOnly *bool, first *int, after *string) (*model.NotificationConnection, error) {
+	sender := utils.GetSender(ctx)
+	return notificationRepository.GetNotifications(sender, unreadOnly != nil && *unreadOnly, first, after)
+}
+
+func (r *queryResolver) UnreadNotificationCount(ctx context.Context) (int, error) {
+	sender := utils.GetSender(ctx)
+	return notificationRepository.UnreadCount(sender)
+}
+
+func (r *queryResolver) TrendingByTag(ctx context.Context, tag string, first *int, after *string, region *model.RegionFilter) (*model.FeedPostConnection, error) {
+	nickname := utils.GetSender(ctx)
+	return feedRepository.GetTrending(nickname, &tag, region, first, after)
 }
 
 func (r *queryResolver) User(ctx context.Context, nickname string) (*model.User, error) {
-	return userRepository.FindOne(nickname)
+	return userRepository.FindOne(nickname, utils.GetViewer(ctx))
 }
 
-func (r *queryResolver) UserPosts(ctx context.Context, nickname string, page *int) ([]*model.Post, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return postRepository.GetPosts(sender, nickname, page)
+func (r *queryResolver) Search(ctx context.Context, query string, types []model.SearchType, auctionStatus []model.AuctionStatus, first *int, after *string) (*model.SearchHitConnection, error) {
+	sender := utils.GetSender(ctx)
+	return searchRepository.Search(sender, query, types, auctionStatus, first, after)
 }
 
-func (r *queryResolver) Comments(ctx context.Context, postID string, page *int) ([]*model.PostComment, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return postRepository.GetComments(sender, postID, page)
+func (r *queryResolver) UserPosts(ctx context.Context, nickname string, first *int, after *string) (*model.PostConnection, error) {
+	sender := utils.GetSender(ctx)
+	return postRepository.GetPosts(sender, nickname, first, after)
+}
+
+func (r *queryResolver) PostLikers(ctx context.Context, postID string, first *int, after *string) (*model.FeedUserConnection, error) {
+	return postRepository.GetPostLikers(postID, first, after)
+}
+
+func (r *queryResolver) Comments(ctx context.Context, postID string, parentID *string, first *int, after *string) (*model.PostCommentConnection, error) {
+	sender := utils.GetSender(ctx)
+	return postRepository.GetComments(sender, postID, parentID, first, after)
 }
 
 func (r *queryResolver) Tags(ctx context.Context) ([]string, error) {
@@ -290,63 +414,50 @@ func (r *queryResolver) UserTags(ctx context.Context, nickname string) ([]string
 	return tagsRepository.GetUserTags(nickname)
 }
 
-func (r *queryResolver) UsersByTags(ctx context.Context, tags []string, page *int) ([]*model.User, error) {
-	return tagsRepository.GetUsersPerTags(tags, page)
+func (r *queryResolver) UsersByTags(ctx context.Context, tags []string, first *int, after *string) (*model.UserConnection, error) {
+	return tagsRepository.GetUsersPerTags(tags, first, after)
 }
 
-func (r *queryResolver) Auctions(ctx context.Context, page *int) ([]*model.FeedAuction, error) {
-	return auctionRepository.GetAuctions(page)
+func (r *queryResolver) Auctions(ctx context.Context, first *int, after *string, filter *model.AuctionFilter, sort *model.AuctionSort) (*model.FeedAuctionConnection, error) {
+	sender := utils.GetViewer(ctx)
+	return auctionRepository.GetAuctions(sender, first, after, filter, sort)
 }
 
 func (r *queryResolver) Order(ctx context.Context, orderID string) (*model.Order, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return nil, err
-	}
+	sender := utils.GetSender(ctx)
 	return orderRepository.GetOrder(sender, orderID)
 }
 
 func (r *queryResolver) Orders(ctx context.Context) ([]*model.Order, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return nil, err
-	}
+	sender := utils.GetSender(ctx)
 	return orderRepository.GetOrders(sender)
 }
 
 func (r *queryResolver) Login(ctx context.Context, nickname string, password string) (*model.Login, error) {
-	return authRepository.Login(nickname, password)
+	return authRepository.Login(ctx, nickname, password)
 }
 
 func (r *queryResolver) IsFollowing(ctx context.Context, nickname string) (bool, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return false, err
-	}
+	sender := utils.GetSender(ctx)
 	return userRepository.IsFollowing(sender, nickname), nil
 }
 
 func (r *queryResolver) AcceptedBids(ctx context.Context) ([]*model.FeedAuction, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return nil, err
-	}
+	sender := utils.GetSender(ctx)
 	return auctionRepository.AcceptedBids(sender)
 }
 
 func (r *queryResolver) BidPaymentLink(ctx context.Context, auctionID string, bidID string) (string, error) {
-	sender, err := utils.GetSenderFromTokenHTTP(ctx)
-	if err != nil {
-		return "", err
-	}
+	sender := utils.GetSender(ctx)
 	return auctionRepository.BidPaymentLink(sender, auctionID, bidID)
 }
 
+func (r *queryResolver) MailQueueStats(ctx context.Context) (*model.MailQueueStats, error) {
+	return mailOutbox.Stats()
+}
+
 func (r *subscriptionResolver) NewChatMessage(ctx context.Context) (<-chan *model.Message, error) {
-	sender, err := utils.GetSenderFromTokenSocket(ctx)
-	if err != nil {
-		return nil, err
-	}
+	sender := utils.GetSender(ctx)
 	return chatRepository.NewChatMessage(sender)
 }
 
@@ -1,6 +1,12 @@
 package middleware
 
-import "github.com/gin-gonic/gin"
+import (
+	"github.com/eaemenkkstudios/cancanvas-backend/repository"
+	"github.com/eaemenkkstudios/cancanvas-backend/utils"
+	"github.com/gin-gonic/gin"
+)
+
+var userRepository = repository.NewUserRepository()
 
 // ResetPasswordHandler function
 func ResetPasswordHandler() gin.HandlerFunc {
@@ -10,3 +16,20 @@ func ResetPasswordHandler() gin.HandlerFunc {
 		return
 	}
 }
+
+// VerifyEmailHandler function
+func VerifyEmailHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.Request.URL.Query().Get("token")
+		sender, email, err := utils.GetSenderAndEmailFromToken(token)
+		if err == nil {
+			_, err = userRepository.VerifyEmail(sender, email)
+		}
+		if err != nil {
+			c.Redirect(302, "cancavas://verifyemail?success=false")
+			return
+		}
+		c.Redirect(302, "cancavas://verifyemail?success=true")
+		return
+	}
+}
@@ -2,6 +2,9 @@ package middleware
 
 import (
 	"context"
+	"net"
+	"net/http"
+	"os"
 	"strings"
 
 	"github.com/99designs/gqlgen/graphql/handler"
@@ -19,16 +22,83 @@ func PlaygroundHandler() gin.HandlerFunc {
 	}
 }
 
+// parseProxies reads a comma separated list of IPs and CIDRs
+func parseProxies(list string) []*net.IPNet {
+	proxies := make([]*net.IPNet, 0)
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			if ip := net.ParseIP(entry); ip != nil && ip.To4() != nil {
+				entry += "/32"
+			} else {
+				entry += "/128"
+			}
+		}
+		if _, network, err := net.ParseCIDR(entry); err == nil {
+			proxies = append(proxies, network)
+		}
+	}
+	return proxies
+}
+
+func isTrusted(ip string, proxies []*net.IPNet) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, network := range proxies {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP returns the address of the client that sent r. X-Forwarded-For
+// is only believed as far as the hops that appended to it are trusted
+// proxies, since clients can send the header themselves.
+func clientIP(r *http.Request, proxies []*net.IPNet) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	if !isTrusted(ip, proxies) {
+		return ip
+	}
+	hops := strings.Split(r.Header.Get("X-Forwarded-For"), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		ip = hop
+		if !isTrusted(hop, proxies) {
+			break
+		}
+	}
+	return ip
+}
+
 // GraphQLHandler function
 func GraphQLHandler() gin.HandlerFunc {
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}}))
+	proxies := parseProxies(os.Getenv("TRUSTED_PROXIES"))
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+		Resolvers: &graph.Resolver{},
+		Directives: generated.DirectiveRoot{
+			HasRole: graph.HasRole,
+		},
+	}))
 	return func(c *gin.Context) {
+		ctx := context.WithValue(c.Request.Context(), "ip", clientIP(c.Request, proxies))
 		token := c.GetHeader("Authorization")
 		token = strings.TrimSpace(token)
 		if token != "" {
-			ctx := context.WithValue(c.Request.Context(), "token", token)
-			c.Request = c.Request.WithContext(ctx)
+			ctx = context.WithValue(ctx, "token", token)
 		}
+		c.Request = c.Request.WithContext(ctx)
 		srv.ServeHTTP(c.Writer, c.Request)
 	}
 }
@@ -0,0 +1,287 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AttemptStore interface
+type AttemptStore interface {
+	Get(key string) (*Attempt, error)
+	// Record counts an attempt for key under policy in a single atomic step
+	// and returns the attempt as stored afterwards
+	Record(key string, policy ThrottlePolicy, now time.Time) (*Attempt, error)
+	// Release takes back one attempt counted for key
+	Release(key string) error
+	Reset(key string) error
+}
+
+// Attempt struct
+type Attempt struct {
+	Key         string    `bson:"_id"`
+	Count       int       `bson:"count"`
+	WindowStart time.Time `bson:"windowstart"`
+	LockedUntil time.Time `bson:"lockeduntil"`
+	ExpiresAt   time.Time `bson:"expiresat"`
+}
+
+// ThrottlePolicy struct
+type ThrottlePolicy struct {
+	// MaxAttempts is how many attempts are free inside Window
+	MaxAttempts int
+	// Window is how long attempts are remembered
+	Window time.Duration
+	// BaseLockout is the lockout after the first attempt over MaxAttempts,
+	// doubling with every further attempt
+	BaseLockout time.Duration
+	// MaxLockout caps the exponential backoff
+	MaxLockout time.Duration
+}
+
+// Throttle policies
+var (
+	LoginAccountPolicy = ThrottlePolicy{MaxAttempts: 5, Window: time.Hour, BaseLockout: 30 * time.Second, MaxLockout: 15 * time.Minute}
+	LoginIPPolicy      = ThrottlePolicy{MaxAttempts: 20, Window: time.Hour, BaseLockout: 30 * time.Second, MaxLockout: 15 * time.Minute}
+	ResetEmailPolicy   = ThrottlePolicy{MaxAttempts: 3, Window: time.Hour, BaseLockout: time.Hour, MaxLockout: time.Hour}
+	ResetIPPolicy      = ThrottlePolicy{MaxAttempts: 10, Window: time.Hour, BaseLockout: time.Hour, MaxLockout: time.Hour}
+)
+
+// Throttle struct
+type Throttle struct {
+	store AttemptStore
+	now   func() time.Time
+}
+
+// NewThrottle function
+func NewThrottle(store AttemptStore) *Throttle {
+	return &Throttle{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Check returns an error while key is locked out. Empty keys are never throttled.
+func (t *Throttle) Check(key string) error {
+	if key == "" {
+		return nil
+	}
+	attempt, err := t.store.Get(key)
+	if err != nil || attempt == nil {
+		return nil
+	}
+	if wait := attempt.LockedUntil.Sub(t.now()); wait > 0 {
+		return fmt.Errorf("Too many attempts, try again in %d seconds", int(math.Ceil(wait.Seconds())))
+	}
+	return nil
+}
+
+// Fail records a failed attempt for key and extends the lockout once the
+// policy's free attempts are used up
+func (t *Throttle) Fail(key string, policy ThrottlePolicy) error {
+	if key == "" {
+		return nil
+	}
+	_, err := t.record(key, policy)
+	return err
+}
+
+// Hit counts an action that is rate limited even when it succeeds and
+// returns an error once key goes over its limit
+func (t *Throttle) Hit(key string, policy ThrottlePolicy) error {
+	if key == "" {
+		return nil
+	}
+	if err := t.Check(key); err != nil {
+		return err
+	}
+	attempt, err := t.record(key, policy)
+	if err != nil {
+		return err
+	}
+	if attempt.Count > policy.MaxAttempts {
+		return t.Check(key)
+	}
+	return nil
+}
+
+func (t *Throttle) record(key string, policy ThrottlePolicy) (*Attempt, error) {
+	return t.store.Record(key, policy, t.now())
+}
+
+// lockout is how long a key is locked out once it went over attempts over
+// the free ones
+func (policy ThrottlePolicy) lockout(over int) time.Duration {
+	lockout := policy.BaseLockout
+	for i := 1; i < over && lockout < policy.MaxLockout; i++ {
+		lockout *= 2
+	}
+	if lockout > policy.MaxLockout {
+		lockout = policy.MaxLockout
+	}
+	return lockout
+}
+
+// Release gives back an attempt counted by Hit that turned out to be
+// legitimate. A lockout already in place stays.
+func (t *Throttle) Release(key string) error {
+	if key == "" {
+		return nil
+	}
+	return t.store.Release(key)
+}
+
+// Reset forgets every attempt recorded for key
+func (t *Throttle) Reset(key string) error {
+	return t.store.Reset(key)
+}
+
+type mongoAttemptStore struct {
+	collection *mongo.Collection
+}
+
+func (s *mongoAttemptStore) Get(key string) (*Attempt, error) {
+	result := s.collection.FindOne(context.TODO(), bson.M{"_id": key})
+	var attempt Attempt
+	err := result.Decode(&attempt)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &attempt, nil
+}
+
+func milliseconds(d time.Duration) int64 {
+	return int64(d / time.Millisecond)
+}
+
+// Record starts a new window or counts the attempt within the current one
+// with an update pipeline, so concurrent attempts can't overwrite each other.
+// The lockout is policy.lockout computed by the server.
+func (s *mongoAttemptStore) Record(key string, policy ThrottlePolicy, now time.Time) (*Attempt, error) {
+	stale := bson.M{"$lt": bson.A{bson.M{"$ifNull": bson.A{"$windowstart", time.Time{}}}, now.Add(-policy.Window)}}
+	over := bson.M{"$subtract": bson.A{"$count", policy.MaxAttempts}}
+	lockout := bson.M{"$min": bson.A{
+		milliseconds(policy.MaxLockout),
+		bson.M{"$multiply": bson.A{
+			milliseconds(policy.BaseLockout),
+			// the exponent is capped so that the product can't overflow
+			bson.M{"$pow": bson.A{2, bson.M{"$min": bson.A{bson.M{"$subtract": bson.A{over, 1}}, 30}}}},
+		}},
+	}}
+	result := s.collection.FindOneAndUpdate(context.TODO(), bson.M{"_id": key}, []bson.M{
+		{"$set": bson.M{
+			"windowstart": bson.M{"$cond": bson.A{stale, now, "$windowstart"}},
+			"count":       bson.M{"$add": bson.A{bson.M{"$cond": bson.A{stale, 0, "$count"}}, 1}},
+			"lockeduntil": bson.M{"$cond": bson.A{stale, time.Time{}, "$lockeduntil"}},
+		}},
+		{"$set": bson.M{
+			"lockeduntil": bson.M{"$cond": bson.A{
+				bson.M{"$gt": bson.A{over, 0}},
+				bson.M{"$add": bson.A{now, lockout}},
+				"$lockeduntil",
+			}},
+		}},
+		{"$set": bson.M{
+			"expiresat": bson.M{"$max": bson.A{
+				bson.M{"$add": bson.A{"$windowstart", milliseconds(policy.Window)}},
+				"$lockeduntil",
+			}},
+		}},
+	}, options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After))
+	var attempt Attempt
+	if err := result.Decode(&attempt); err != nil {
+		return nil, err
+	}
+	return &attempt, nil
+}
+
+func (s *mongoAttemptStore) Release(key string) error {
+	_, err := s.collection.UpdateOne(context.TODO(), bson.M{"_id": key, "count": bson.M{"$gt": 0}}, bson.M{
+		"$inc": bson.M{"count": -1},
+	})
+	return err
+}
+
+func (s *mongoAttemptStore) Reset(key string) error {
+	_, err := s.collection.DeleteOne(context.TODO(), bson.M{"_id": key})
+	return err
+}
+
+// NewMongoAttemptStore function
+func NewMongoAttemptStore(client *mongo.Database) AttemptStore {
+	collection := client.Collection(CollectionAttempts)
+	collection.Indexes().CreateOne(context.TODO(), mongo.IndexModel{
+		Keys:    bson.M{"expiresat": 1},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	return &mongoAttemptStore{
+		collection,
+	}
+}
+
+type memoryAttemptStore struct {
+	mutex    sync.Mutex
+	attempts map[string]Attempt
+}
+
+func (s *memoryAttemptStore) Get(key string) (*Attempt, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	attempt, ok := s.attempts[key]
+	if !ok {
+		return nil, nil
+	}
+	return &attempt, nil
+}
+
+func (s *memoryAttemptStore) Record(key string, policy ThrottlePolicy, now time.Time) (*Attempt, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	attempt, ok := s.attempts[key]
+	if !ok || now.Sub(attempt.WindowStart) > policy.Window {
+		attempt = Attempt{Key: key, WindowStart: now}
+	}
+	attempt.Count++
+	if over := attempt.Count - policy.MaxAttempts; over > 0 {
+		attempt.LockedUntil = now.Add(policy.lockout(over))
+	}
+	attempt.ExpiresAt = attempt.WindowStart.Add(policy.Window)
+	if attempt.LockedUntil.After(attempt.ExpiresAt) {
+		attempt.ExpiresAt = attempt.LockedUntil
+	}
+	s.attempts[key] = attempt
+	return &attempt, nil
+}
+
+func (s *memoryAttemptStore) Release(key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if attempt, ok := s.attempts[key]; ok && attempt.Count > 0 {
+		attempt.Count--
+		s.attempts[key] = attempt
+	}
+	return nil
+}
+
+func (s *memoryAttemptStore) Reset(key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.attempts, key)
+	return nil
+}
+
+// NewMemoryAttemptStore function
+func NewMemoryAttemptStore() AttemptStore {
+	return &memoryAttemptStore{
+		attempts: make(map[string]Attempt),
+	}
+}
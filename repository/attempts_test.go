@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+var testPolicy = ThrottlePolicy{MaxAttempts: 3, Window: time.Hour, BaseLockout: time.Minute, MaxLockout: 5 * time.Minute}
+
+// attemptStores returns the stores every throttle test runs against
+func attemptStores(t *testing.T) map[string]func(t *testing.T) AttemptStore {
+	return map[string]func(t *testing.T) AttemptStore{
+		"memory": func(t *testing.T) AttemptStore { return NewMemoryAttemptStore() },
+		"mongo":  func(t *testing.T) AttemptStore { return NewMongoAttemptStore(testDatabase(t)) },
+	}
+}
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestThrottle(store AttemptStore) (*Throttle, *testClock) {
+	clock := &testClock{time.Unix(1600000000, 0)}
+	throttle := NewThrottle(store)
+	throttle.now = clock.Now
+	return throttle, clock
+}
+
+func TestPolicyLockout(t *testing.T) {
+	tests := []struct {
+		over int
+		want time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{3, 4 * time.Minute},
+		{4, 5 * time.Minute},
+		{100, 5 * time.Minute},
+	}
+	for _, test := range tests {
+		if got := testPolicy.lockout(test.over); got != test.want {
+			t.Errorf("lockout(%d) = %v, want %v", test.over, got, test.want)
+		}
+	}
+}
+
+func TestThrottleFail(t *testing.T) {
+	for name, newStore := range attemptStores(t) {
+		t.Run(name, func(t *testing.T) {
+			store := newStore(t)
+			throttle, clock := newTestThrottle(store)
+			for i := 0; i < testPolicy.MaxAttempts; i++ {
+				if err := throttle.Fail("key", testPolicy); err != nil {
+					t.Fatal(err)
+				}
+				if err := throttle.Check("key"); err != nil {
+					t.Fatalf("locked out after %d attempts: %v", i+1, err)
+				}
+			}
+			for _, lockout := range []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute} {
+				if err := throttle.Fail("key", testPolicy); err != nil {
+					t.Fatal(err)
+				}
+				attempt, err := store.Get("key")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := clock.Now().Add(lockout); !attempt.LockedUntil.Equal(want) {
+					t.Errorf("locked until %v, want %v", attempt.LockedUntil, want)
+				}
+				if throttle.Check("key") == nil {
+					t.Error("attempt over the limit wasn't locked out")
+				}
+			}
+			clock.Advance(5 * time.Minute)
+			if err := throttle.Check("key"); err != nil {
+				t.Errorf("still locked out after the lockout: %v", err)
+			}
+		})
+	}
+}
+
+func TestThrottleWindow(t *testing.T) {
+	for name, newStore := range attemptStores(t) {
+		t.Run(name, func(t *testing.T) {
+			store := newStore(t)
+			throttle, clock := newTestThrottle(store)
+			for i := 0; i < testPolicy.MaxAttempts; i++ {
+				throttle.Fail("key", testPolicy)
+			}
+			clock.Advance(testPolicy.Window + time.Second)
+			throttle.Fail("key", testPolicy)
+			attempt, err := store.Get("key")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if attempt.Count != 1 {
+				t.Errorf("count = %d after the window expired, want 1", attempt.Count)
+			}
+			if !attempt.WindowStart.Equal(clock.Now()) {
+				t.Errorf("window started at %v, want %v", attempt.WindowStart, clock.Now())
+			}
+			if want := clock.Now().Add(testPolicy.Window); !attempt.ExpiresAt.Equal(want) {
+				t.Errorf("expires at %v, want %v", attempt.ExpiresAt, want)
+			}
+			if err := throttle.Check("key"); err != nil {
+				t.Errorf("locked out in a new window: %v", err)
+			}
+		})
+	}
+}
+
+func TestThrottleHit(t *testing.T) {
+	for name, newStore := range attemptStores(t) {
+		t.Run(name, func(t *testing.T) {
+			throttle, clock := newTestThrottle(newStore(t))
+			for i := 0; i < testPolicy.MaxAttempts; i++ {
+				if err := throttle.Hit("key", testPolicy); err != nil {
+					t.Fatalf("hit %d: %v", i+1, err)
+				}
+			}
+			if throttle.Hit("key", testPolicy) == nil {
+				t.Fatal("hit over the limit wasn't refused")
+			}
+			if throttle.Hit("key", testPolicy) == nil {
+				t.Fatal("hit during the lockout wasn't refused")
+			}
+			clock.Advance(time.Minute)
+			if throttle.Hit("key", testPolicy) == nil {
+				t.Fatal("hit over the limit after the lockout wasn't refused")
+			}
+		})
+	}
+}
+
+func TestThrottleReset(t *testing.T) {
+	for name, newStore := range attemptStores(t) {
+		t.Run(name, func(t *testing.T) {
+			throttle, _ := newTestThrottle(newStore(t))
+			for i := 0; i <= testPolicy.MaxAttempts; i++ {
+				throttle.Fail("key", testPolicy)
+			}
+			if throttle.Check("key") == nil {
+				t.Fatal("key wasn't locked out")
+			}
+			if err := throttle.Reset("key"); err != nil {
+				t.Fatal(err)
+			}
+			if err := throttle.Check("key"); err != nil {
+				t.Errorf("still locked out after reset: %v", err)
+			}
+		})
+	}
+}
+
+func TestThrottleEmptyKey(t *testing.T) {
+	throttle, _ := newTestThrottle(NewMemoryAttemptStore())
+	for i := 0; i < 2*testPolicy.MaxAttempts; i++ {
+		if err := throttle.Hit("", testPolicy); err != nil {
+			t.Fatal(err)
+		}
+		throttle.Fail("", testPolicy)
+	}
+	if err := throttle.Check(""); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestThrottleConcurrentFail(t *testing.T) {
+	const attempts = 50
+	for name, newStore := range attemptStores(t) {
+		t.Run(name, func(t *testing.T) {
+			store := newStore(t)
+			throttle, _ := newTestThrottle(store)
+			var wg sync.WaitGroup
+			for i := 0; i < attempts; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if err := throttle.Fail("key", testPolicy); err != nil {
+						t.Error(err)
+					}
+				}()
+			}
+			wg.Wait()
+			attempt, err := store.Get("key")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if attempt.Count != attempts {
+				t.Errorf("count = %d, want %d", attempt.Count, attempts)
+			}
+		})
+	}
+}
+
+// TestThrottleConcurrentHit checks that no more than the free attempts get
+// through when they all arrive at once
+func TestThrottleConcurrentHit(t *testing.T) {
+	const attempts = 50
+	for name, newStore := range attemptStores(t) {
+		t.Run(name, func(t *testing.T) {
+			throttle, _ := newTestThrottle(newStore(t))
+			var mutex sync.Mutex
+			allowed := 0
+			calls := make([]func(), attempts)
+			for i := range calls {
+				calls[i] = func() {
+					if throttle.Hit("key", testPolicy) == nil {
+						mutex.Lock()
+						allowed++
+						mutex.Unlock()
+					}
+				}
+			}
+			runConcurrently(calls...)
+			if allowed != testPolicy.MaxAttempts {
+				t.Errorf("%d attempts got through, want %d", allowed, testPolicy.MaxAttempts)
+			}
+		})
+	}
+}
+
+func TestThrottleRelease(t *testing.T) {
+	for name, newStore := range attemptStores(t) {
+		t.Run(name, func(t *testing.T) {
+			store := newStore(t)
+			throttle, _ := newTestThrottle(store)
+			if err := throttle.Release("key"); err != nil {
+				t.Fatal(err)
+			}
+			for i := 0; i < 2*testPolicy.MaxAttempts; i++ {
+				if err := throttle.Hit("key", testPolicy); err != nil {
+					t.Fatalf("hit %d: %v", i+1, err)
+				}
+				if err := throttle.Release("key"); err != nil {
+					t.Fatal(err)
+				}
+			}
+			attempt, err := store.Get("key")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if attempt.Count != 0 {
+				t.Errorf("count = %d, want 0", attempt.Count)
+			}
+			for i := 0; i <= testPolicy.MaxAttempts; i++ {
+				throttle.Hit("key", testPolicy)
+			}
+			throttle.Release("key")
+			if throttle.Check("key") == nil {
+				t.Error("release lifted the lockout")
+			}
+		})
+	}
+}
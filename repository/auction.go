@@ -3,54 +3,273 @@ package repository
 import (
 	"context"
 	"errors"
+	"io"
+	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"github.com/eaemenkkstudios/cancanvas-backend/service"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // AuctionRepository interface
 type AuctionRepository interface {
-	GetAuctions(page *int) ([]*model.FeedAuction, error)
-	CreateAuction(sender, description string, offer float64) (*model.Auction, error)
+	GetAuctions(sender string, first *int, after *string, filter *model.AuctionFilter, sort *model.AuctionSort) (*model.FeedAuctionConnection, error)
+	CreateAuction(sender string, input model.NewAuction) (*model.Auction, error)
+	EditAuction(sender, auctionID string, input model.EditAuction) (*model.Auction, error)
 	DeleteAuction(sender, auctionID string) (bool, error)
+	RemoveAuction(auctionID string) (bool, error)
 	CreateBid(sender, auctionID, deadline string, price float64) (*model.Bid, error)
 	DeleteBid(sender, auctionID, bidID string) (bool, error)
-	AcceptBid(sender, auctionID, bidID string) (bool, error)
+	AcceptBid(sender, auctionID, bidID string, revision *int) (bool, error)
+	ReviseBid(sender, auctionID, bidID string, price *float64, deadline, message *string) (*model.Bid, error)
+	CounterOfferBid(sender, auctionID, bidID string, price *float64, deadline, message *string) (*model.Bid, error)
+	AcceptCounterOffer(sender, auctionID, bidID string) (*model.Bid, error)
+	SendBidMessage(sender, auctionID, bidID, message string) (*model.BidMessage, error)
+	UnacceptBid(sender, auctionID, bidID string) (bool, error)
 	AcceptedBids(sender string) ([]*model.FeedAuction, error)
 	BidPaymentLink(sender, auctionID, bidID string) (string, error)
+	CloseAuction(sender, auctionID string) (bool, error)
+	CancelAuction(sender, auctionID string) (bool, error)
+	MarkAuctionDelivered(sender, auctionID string) (bool, error)
+	CompleteAuction(sender, auctionID string) (bool, error)
+	DisputeAuction(sender, auctionID string) (bool, error)
+	ResolveAuctionDispute(auctionID string, status model.AuctionStatus) (bool, error)
 }
 
+// Bounds of the parameters a host can choose for an auction
+const (
+	DefaultAuctionDuration = 72 * time.Hour
+	MinAuctionDuration     = time.Hour
+	MaxAuctionDuration     = 30 * 24 * time.Hour
+	MaxAuctionTags         = 10
+	MaxAuctionReferences   = 10
+)
+
 type auctionRepository struct {
-	client *mongo.Database
-	order  OrderRepository
+	client     *mongo.Database
+	order      OrderRepository
+	awsSession service.AwsService
 }
 
 type feedAuction struct {
-	ID          string        `bson:"_id"`
-	Host        []*UserSchema `bson:"host"`
-	Description string        `bson:"description"`
-	Offer       float64       `bson:"offer"`
-	Bids        []*model.Bid  `bson:"bids"`
-	Timestamp   string        `bson:"timestamp"`
-	Deadline    string        `bson:"deadline"`
+	ID           string              `bson:"_id"`
+	Host         []*UserSchema       `bson:"host"`
+	Description  string              `bson:"description"`
+	Offer        float64             `bson:"offer"`
+	MinBudget    float64             `bson:"minbudget"`
+	MaxBudget    float64             `bson:"maxbudget"`
+	DeliveryDate string              `bson:"deliverydate"`
+	Tags         []string            `bson:"tags"`
+	References   []string            `bson:"references"`
+	Bids         []*model.Bid        `bson:"bids"`
+	BidCount     int                 `bson:"bidcount"`
+	Timestamp    string              `bson:"timestamp"`
+	Deadline     string              `bson:"deadline"`
+	Status       model.AuctionStatus `bson:"status"`
+}
+
+func newFeedAuctionModel(a *feedAuction, viewer string) *model.FeedAuction {
+	return &model.FeedAuction{
+		ID: a.ID,
+		Host: &model.FeedUser{
+			Name:     a.Host[0].Name,
+			Nickname: a.Host[0].Nickname,
+			Picture:  a.Host[0].Picture,
+		},
+		Bids:         visibleBids(a.Bids, a.Host[0].Nickname, viewer),
+		BidCount:     a.BidCount,
+		Deadline:     a.Deadline,
+		Description:  a.Description,
+		Offer:        a.Offer,
+		MinBudget:    a.MinBudget,
+		MaxBudget:    a.MaxBudget,
+		DeliveryDate: a.DeliveryDate,
+		Tags:         a.Tags,
+		References:   a.References,
+		Timestamp:    a.Timestamp,
+		Status:       a.Status,
+	}
+}
+
+func (db *auctionRepository) requireVerifiedEmail(sender string) error {
+	count, err := db.client.Collection(CollectionUsers).CountDocuments(context.TODO(), bson.M{
+		"_id":           sender,
+		"emailverified": true,
+	})
+	if err != nil || count == 0 {
+		return errors.New("You need to verify your email address first")
+	}
+	return nil
 }
 
-func (db *auctionRepository) CreateAuction(sender, description string, offer float64) (*model.Auction, error) {
+func parseUnixTime(value, name string) (int64, error) {
+	t, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, errors.New("Invalid " + name)
+	}
+	return t, nil
+}
+
+func validateBudget(min, max float64) error {
+	if min <= 0 || max < min {
+		return errors.New("The budget must be positive and its minimum can't exceed its maximum")
+	}
+	return nil
+}
+
+func validateDeadline(deadline int64, now time.Time) error {
+	if deadline < now.Add(MinAuctionDuration).Unix() || deadline > now.Add(MaxAuctionDuration).Unix() {
+		return errors.New("The deadline must be between 1 hour and 30 days from now")
+	}
+	return nil
+}
+
+func validateDeliveryDate(deliveryDate, deadline int64) error {
+	if deliveryDate <= deadline {
+		return errors.New("The delivery date must be after the deadline")
+	}
+	return nil
+}
+
+// validateTags returns the distinct tags, lowercased, as long as all of
+// them are in the tags collection
+func (db *auctionRepository) validateTags(tags []string) ([]string, error) {
+	distinct := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if !stringInSlice(tag, distinct) {
+			distinct = append(distinct, tag)
+		}
+	}
+	if len(distinct) > MaxAuctionTags {
+		return nil, errors.New("An auction can't have more than " + strconv.Itoa(MaxAuctionTags) + " tags")
+	}
+	if len(distinct) == 0 {
+		return distinct, nil
+	}
+	count, err := db.client.Collection(CollectionTags).CountDocuments(context.TODO(), bson.M{"_id": bson.M{"$in": distinct}})
+	if err != nil {
+		return nil, errors.New("Could not load tags")
+	}
+	if int(count) != len(distinct) {
+		return nil, errors.New("Invalid tag")
+	}
+	return distinct, nil
+}
+
+// sniffImage checks the content of upload, rather than the type the client
+// claims, and stores the detected type with it
+func sniffImage(upload *graphql.Upload) error {
+	seeker, ok := upload.File.(io.Seeker)
+	if !ok {
+		return errors.New("Could not read file")
+	}
+	head := make([]byte, 512)
+	n, err := io.ReadFull(upload.File, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return errors.New("Could not read file")
+	}
+	if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+		return errors.New("Could not read file")
+	}
+	contentType := http.DetectContentType(head[:n])
+	if !strings.HasPrefix(contentType, "image/") {
+		return errors.New("References must be images")
+	}
+	upload.ContentType = contentType
+	return nil
+}
+
+// uploadReferences stores the reference images of an auction. If one of
+// them fails, those already stored are deleted.
+func (db *auctionRepository) uploadReferences(uploads []*graphql.Upload) ([]string, error) {
+	urls := make([]string, 0, len(uploads))
+	for _, upload := range uploads {
+		if err := sniffImage(upload); err != nil {
+			db.deleteReferences(urls)
+			return nil, err
+		}
+		url, err := db.awsSession.UploadFile(*upload, "reference")
+		if err != nil {
+			db.deleteReferences(urls)
+			return nil, errors.New("Could not upload file")
+		}
+		urls = append(urls, url)
+	}
+	return urls, nil
+}
+
+func (db *auctionRepository) deleteReferences(urls []string) {
+	urlPrefix := db.awsSession.GetURLPrefix()
+	for _, url := range urls {
+		db.awsSession.DeleteFile(strings.TrimPrefix(url, urlPrefix))
+	}
+}
+
+func (db *auctionRepository) CreateAuction(sender string, input model.NewAuction) (*model.Auction, error) {
+	if err := db.requireVerifiedEmail(sender); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	deadline := now.Add(DefaultAuctionDuration).Unix()
+	if input.Deadline != nil {
+		d, err := parseUnixTime(*input.Deadline, "deadline")
+		if err != nil {
+			return nil, err
+		}
+		if err := validateDeadline(d, now); err != nil {
+			return nil, err
+		}
+		deadline = d
+	}
+	deliveryDate, err := parseUnixTime(input.DeliveryDate, "deliveryDate")
+	if err != nil {
+		return nil, err
+	}
+	if err := validateDeliveryDate(deliveryDate, deadline); err != nil {
+		return nil, err
+	}
+	if err := validateBudget(input.MinBudget, input.MaxBudget); err != nil {
+		return nil, err
+	}
+	tags, err := db.validateTags(input.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if len(input.References) > MaxAuctionReferences {
+		return nil, errors.New("An auction can't have more than " + strconv.Itoa(MaxAuctionReferences) + " references")
+	}
+	references, err := db.uploadReferences(input.References)
+	if err != nil {
+		return nil, err
+	}
 	auction := &model.Auction{
 		Host:        sender,
-		Offer:       offer,
-		Description: description,
-		Bids:        make([]*model.Bid, 0),
-		Deadline:    strconv.FormatInt(time.Now().Add(72*time.Hour).Unix(), 10),
-		Timestamp:   strconv.FormatInt(time.Now().Unix(), 10),
+		Description: input.Description,
+		// offer is kept for clients that don't know about budgets yet
+		Offer:        input.MaxBudget,
+		MinBudget:    input.MinBudget,
+		MaxBudget:    input.MaxBudget,
+		DeliveryDate: strconv.FormatInt(deliveryDate, 10),
+		Tags:         tags,
+		References:   references,
+		Bids:         make([]*model.Bid, 0),
+		BidCount:     0,
+		Deadline:     strconv.FormatInt(deadline, 10),
+		Timestamp:    strconv.FormatInt(now.Unix(), 10),
+		Status:       model.AuctionStatusOpen,
 	}
 	collection := db.client.Collection(CollectionAuctions)
 	result, err := collection.InsertOne(context.TODO(), auction)
 	if err != nil {
+		db.deleteReferences(references)
 		return nil, err
 	}
 	id := result.InsertedID.(primitive.ObjectID).Hex()
@@ -58,29 +277,317 @@ func (db *auctionRepository) CreateAuction(sender, description string, offer flo
 	return auction, nil
 }
 
+// EditAuction changes the parameters of an auction until a bid is accepted.
+// The deadline can only be moved while the auction is open.
+func (db *auctionRepository) EditAuction(sender, auctionID string, input model.EditAuction) (*model.Auction, error) {
+	id, err := primitive.ObjectIDFromHex(auctionID)
+	if err != nil {
+		return nil, errors.New("Invalid auctionID")
+	}
+	collection := db.client.Collection(CollectionAuctions)
+	var auction model.Auction
+	err = collection.FindOne(context.TODO(), bson.M{"_id": id}).Decode(&auction)
+	if err != nil {
+		return nil, errors.New("Auction not found")
+	}
+	if auction.Host != sender {
+		return nil, errors.New("Unauthorized")
+	}
+	if auction.Status != model.AuctionStatusOpen && auction.Status != model.AuctionStatusClosed {
+		return nil, errors.New("An auction can't be edited after a bid was accepted")
+	}
+	now := time.Now()
+	set := bson.M{}
+	if input.Description != nil {
+		set["description"] = *input.Description
+	}
+	min, max := auction.MinBudget, auction.MaxBudget
+	if input.MinBudget != nil {
+		min = *input.MinBudget
+	}
+	if input.MaxBudget != nil {
+		max = *input.MaxBudget
+	}
+	if input.MinBudget != nil || input.MaxBudget != nil {
+		if err := validateBudget(min, max); err != nil {
+			return nil, err
+		}
+		set["minbudget"] = min
+		set["maxbudget"] = max
+		set["offer"] = max
+	}
+	deadline, err := parseUnixTime(auction.Deadline, "deadline")
+	if err != nil {
+		return nil, err
+	}
+	if input.Deadline != nil {
+		if auction.Status != model.AuctionStatusOpen {
+			return nil, errors.New("The deadline of a closed auction can't be changed")
+		}
+		deadline, err = parseUnixTime(*input.Deadline, "deadline")
+		if err != nil {
+			return nil, err
+		}
+		if err := validateDeadline(deadline, now); err != nil {
+			return nil, err
+		}
+		set["deadline"] = strconv.FormatInt(deadline, 10)
+	}
+	if input.Deadline != nil || input.DeliveryDate != nil {
+		deliveryDate, err := parseUnixTime(auction.DeliveryDate, "deliveryDate")
+		if input.DeliveryDate != nil {
+			deliveryDate, err = parseUnixTime(*input.DeliveryDate, "deliveryDate")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := validateDeliveryDate(deliveryDate, deadline); err != nil {
+			return nil, err
+		}
+		set["deliverydate"] = strconv.FormatInt(deliveryDate, 10)
+	}
+	if input.Tags != nil {
+		tags, err := db.validateTags(input.Tags)
+		if err != nil {
+			return nil, err
+		}
+		set["tags"] = tags
+	}
+	remove := make([]string, 0, len(input.RemoveReferences))
+	for _, url := range input.RemoveReferences {
+		if stringInSlice(url, auction.References) {
+			remove = append(remove, url)
+		}
+	}
+	if len(auction.References)-len(remove)+len(input.AddReferences) > MaxAuctionReferences {
+		return nil, errors.New("An auction can't have more than " + strconv.Itoa(MaxAuctionReferences) + " references")
+	}
+	added, err := db.uploadReferences(input.AddReferences)
+	if err != nil {
+		return nil, err
+	}
+	if len(set) == 0 && len(remove) == 0 && len(added) == 0 {
+		return &auction, nil
+	}
+	// the update is a pipeline so references can be added and removed at
+	// once, which means plain values have to be marked as literals
+	fields := bson.M{}
+	for k, v := range set {
+		fields[k] = bson.M{"$literal": v}
+	}
+	kept := bson.M{"$filter": bson.M{
+		"input": bson.M{"$ifNull": bson.A{"$references", bson.A{}}},
+		"cond":  bson.M{"$not": bson.A{bson.M{"$in": bson.A{"$$this", remove}}}},
+	}}
+	fields["references"] = bson.M{"$concatArrays": bson.A{kept, bson.M{"$literal": added}}}
+	// the auction may have been awarded since it was read, and concurrent
+	// edits may have added references in the meantime
+	filter := bson.M{
+		"_id":    id,
+		"host":   sender,
+		"status": bson.M{"$in": []model.AuctionStatus{model.AuctionStatusOpen, model.AuctionStatusClosed}},
+		"$expr": bson.M{"$lte": bson.A{
+			bson.M{"$add": bson.A{bson.M{"$size": kept}, len(added)}},
+			MaxAuctionReferences,
+		}},
+	}
+	if input.Deadline != nil {
+		filter["status"] = model.AuctionStatusOpen
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var updated model.Auction
+	err = collection.FindOneAndUpdate(context.TODO(), filter, []bson.M{{"$set": fields}}, opts).Decode(&updated)
+	if err != nil {
+		db.deleteReferences(added)
+		return nil, errors.New("Could not edit auction, it may have been awarded or changed in the meantime")
+	}
+	db.deleteReferences(missingFrom(remove, updated.References))
+	return &updated, nil
+}
+
 func (db *auctionRepository) DeleteAuction(sender, auctionID string) (bool, error) {
 	collection := db.client.Collection(CollectionAuctions)
 	id, err := primitive.ObjectIDFromHex(auctionID)
 	if err != nil {
 		return false, errors.New("Invalid auctionID")
 	}
-	result, err := collection.DeleteOne(context.TODO(), bson.M{"_id": id, "host": sender})
+	// once a bid is accepted the bidder has a stake in the auction, so it
+	// has to be cancelled instead
+	var auction model.Auction
+	err = collection.FindOneAndDelete(context.TODO(), bson.M{
+		"_id":    id,
+		"host":   sender,
+		"status": bson.M{"$in": []model.AuctionStatus{model.AuctionStatusOpen, model.AuctionStatusClosed, model.AuctionStatusCancelled}},
+	}).Decode(&auction)
 	if err != nil {
-		return false, err
-	}
-	if result.DeletedCount == 0 {
 		return false, errors.New("Could not delete auction")
 	}
+	db.deleteReferences(auction.References)
 	return true, nil
 }
 
-func (db *auctionRepository) GetAuctions(page *int) ([]*model.FeedAuction, error) {
-	if page == nil || *page < 1 {
-		*page = 1
+func (db *auctionRepository) RemoveAuction(auctionID string) (bool, error) {
+	collection := db.client.Collection(CollectionAuctions)
+	id, err := primitive.ObjectIDFromHex(auctionID)
+	if err != nil {
+		return false, errors.New("Invalid auctionID")
+	}
+	var auction model.Auction
+	err = collection.FindOneAndDelete(context.TODO(), bson.M{"_id": id}).Decode(&auction)
+	if err != nil {
+		return false, errors.New("Auction not found")
+	}
+	db.deleteReferences(auction.References)
+	return true, nil
+}
+
+// auctionOrder describes how the auctions list is sorted. Numeric keys are
+// stored in cursors as strings and parsed back before comparing.
+type auctionOrder struct {
+	field     string
+	ascending bool
+	numeric   bool
+	key       func(a *feedAuction) string
+}
+
+var auctionOrders = map[model.AuctionSort]*auctionOrder{
+	model.AuctionSortNewest: {"timestamp", false, false, func(a *feedAuction) string {
+		return a.Timestamp
+	}},
+	model.AuctionSortHighestOffer: {"maxbudget", false, true, func(a *feedAuction) string {
+		return strconv.FormatFloat(a.MaxBudget, 'f', -1, 64)
+	}},
+	model.AuctionSortLowestOffer: {"maxbudget", true, true, func(a *feedAuction) string {
+		return strconv.FormatFloat(a.MaxBudget, 'f', -1, 64)
+	}},
+	model.AuctionSortEndingSoon: {"deadline", true, false, func(a *feedAuction) string {
+		return a.Deadline
+	}},
+	model.AuctionSortMostBids: {"bidcount", false, true, func(a *feedAuction) string {
+		return strconv.Itoa(a.BidCount)
+	}},
+	model.AuctionSortFewestBids: {"bidcount", true, true, func(a *feedAuction) string {
+		return strconv.Itoa(a.BidCount)
+	}},
+}
+
+// after matches the auctions that come after c in this order
+func (o *auctionOrder) after(c *pageCursor) (bson.M, error) {
+	id, err := primitive.ObjectIDFromHex(c.ID)
+	if err != nil {
+		return nil, errors.New("Invalid cursor")
+	}
+	var key interface{} = c.Key
+	if o.numeric {
+		key, err = strconv.ParseFloat(c.Key, 64)
+		if err != nil {
+			return nil, errors.New("Invalid cursor")
+		}
+	}
+	if o.ascending {
+		return keysetAfterAscending(o.field, key, id), nil
+	}
+	return keysetAfter(o.field, key, id), nil
+}
+
+func (o *auctionOrder) sort() bson.D {
+	direction := -1
+	if o.ascending {
+		direction = 1
+	}
+	return bson.D{{Key: o.field, Value: direction}, {Key: "_id", Value: direction}}
+}
+
+// auctionFilters translates filter into conditions on open auctions
+func (db *auctionRepository) auctionFilters(sender string, filter *model.AuctionFilter) ([]bson.M, error) {
+	filters := []bson.M{{
+		"status":   model.AuctionStatusOpen,
+		"deadline": bson.M{"$gt": strconv.FormatInt(time.Now().Unix(), 10)},
+	}}
+	if filter == nil {
+		return filters, nil
+	}
+	if filter.Query != nil && strings.TrimSpace(*filter.Query) != "" {
+		filters = append(filters, bson.M{"$text": bson.M{"$search": *filter.Query}})
+	}
+	if filter.Near != nil {
+		hosts, err := usersInRegion(db.client, filter.Near)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, bson.M{"host": bson.M{"$in": hosts}})
+	}
+	// an auction is in the price range when its budget overlaps it
+	if filter.MinPrice != nil {
+		filters = append(filters, bson.M{"maxbudget": bson.M{"$gte": *filter.MinPrice}})
+	}
+	if filter.MaxPrice != nil {
+		filters = append(filters, bson.M{"minbudget": bson.M{"$lte": *filter.MaxPrice}})
+	}
+	if len(filter.Tags) > 0 {
+		tags := make([]string, 0, len(filter.Tags))
+		for _, tag := range filter.Tags {
+			tags = append(tags, strings.ToLower(strings.TrimSpace(tag)))
+		}
+		filters = append(filters, bson.M{"tags": bson.M{"$in": tags}})
+	}
+	if filter.DeadlineFrom != nil {
+		from, err := parseUnixTime(*filter.DeadlineFrom, "deadlineFrom")
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, bson.M{"deadline": bson.M{"$gte": strconv.FormatInt(from, 10)}})
+	}
+	if filter.DeadlineTo != nil {
+		to, err := parseUnixTime(*filter.DeadlineTo, "deadlineTo")
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, bson.M{"deadline": bson.M{"$lte": strconv.FormatInt(to, 10)}})
+	}
+	if filter.MinBids != nil {
+		filters = append(filters, bson.M{"bidcount": bson.M{"$gte": *filter.MinBids}})
+	}
+	if filter.MaxBids != nil {
+		filters = append(filters, bson.M{"bidcount": bson.M{"$lte": *filter.MaxBids}})
+	}
+	if filter.NotBidByMe != nil && *filter.NotBidByMe && sender != "" {
+		// nobody can bid in their own auctions either
+		filters = append(filters, bson.M{"host": bson.M{"$ne": sender}, "bids.issuer": bson.M{"$ne": sender}})
+	}
+	return filters, nil
+}
+
+func (db *auctionRepository) GetAuctions(sender string, first *int, after *string, filter *model.AuctionFilter, sort *model.AuctionSort) (*model.FeedAuctionConnection, error) {
+	size, err := pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+	c, err := decodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	order := auctionOrders[model.AuctionSortNewest]
+	if sort != nil {
+		order = auctionOrders[*sort]
+	}
+	filters, err := db.auctionFilters(sender, filter)
+	if err != nil {
+		return nil, err
+	}
+	if c != nil {
+		after, err := order.after(c)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, after)
 	}
 	collection := db.client.Collection(CollectionAuctions)
 	ctx := context.TODO()
 	cursor, err := collection.Aggregate(ctx, mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.M{"$and": filters}}},
+		bson.D{{Key: "$sort", Value: order.sort()}},
+		bson.D{{Key: "$limit", Value: size + 1}},
 		bson.D{{
 			Key: "$lookup",
 			Value: bson.M{
@@ -89,36 +596,43 @@ func (db *auctionRepository) GetAuctions(page *int) ([]*model.FeedAuction, error
 				"foreignField": "_id",
 				"as":           "host",
 			}}},
-		bson.D{{Key: "$skip", Value: int64(PageSize * (*page - 1))}},
-		bson.D{{Key: "$limit", Value: PageSize}},
-		bson.D{{Key: "$sort", Value: bson.M{"timestamp": -1}}},
 	})
 	if err != nil {
-		return nil, errors.New("Could not load posts")
+		return nil, errors.New("Could not load auctions")
+	}
+	connection := &model.FeedAuctionConnection{
+		Edges: make([]*model.FeedAuctionEdge, 0),
 	}
-	var auctions = make([]*model.FeedAuction, 0)
+	hasNextPage := false
 	defer cursor.Close(ctx)
 	for cursor.Next(ctx) {
+		if len(connection.Edges) == size {
+			hasNextPage = true
+			break
+		}
 		var a feedAuction
 		err = cursor.Decode(&a)
-		auctions = append(auctions, &model.FeedAuction{
-			ID: a.ID,
-			Host: &model.FeedUser{
-				Name:     a.Host[0].Name,
-				Nickname: a.Host[0].Nickname,
-				Picture:  a.Host[0].Picture,
-			},
-			Bids:        a.Bids,
-			Deadline:    a.Deadline,
-			Description: a.Description,
-			Offer:       a.Offer,
-			Timestamp:   a.Timestamp,
+		if err != nil {
+			return nil, err
+		}
+		if len(a.Host) == 0 {
+			continue
+		}
+		connection.Edges = append(connection.Edges, &model.FeedAuctionEdge{
+			Cursor: encodeCursor(order.key(&a), a.ID),
+			Node:   newFeedAuctionModel(&a, sender),
 		})
 	}
-	return auctions, err
+	connection.PageInfo = newPageInfo(c, hasNextPage, len(connection.Edges), func(i int) string {
+		return connection.Edges[i].Cursor
+	})
+	return connection, nil
 }
 
 func (db *auctionRepository) CreateBid(sender, auctionID, deadline string, price float64) (*model.Bid, error) {
+	if err := db.requireVerifiedEmail(sender); err != nil {
+		return nil, err
+	}
 	collection := db.client.Collection(CollectionAuctions)
 	id, err := primitive.ObjectIDFromHex(auctionID)
 	if err != nil {
@@ -133,6 +647,9 @@ func (db *auctionRepository) CreateBid(sender, auctionID, deadline string, price
 	if auction.Host == sender {
 		return nil, errors.New("You can't make a bid in your own auction")
 	}
+	if auction.Status != model.AuctionStatusOpen {
+		return nil, errors.New("This auction is no longer accepting Bids")
+	}
 	auctionDeadline, err := strconv.ParseInt(auction.Deadline, 10, 64)
 	if err != nil {
 		return nil, err
@@ -146,18 +663,33 @@ func (db *auctionRepository) CreateBid(sender, auctionID, deadline string, price
 		}
 	}
 	bid := &model.Bid{
-		ID:        primitive.NewObjectID().Hex(),
-		Issuer:    sender,
-		Deadline:  deadline,
-		Price:     price,
+		ID:       primitive.NewObjectID().Hex(),
+		Issuer:   sender,
+		Deadline: deadline,
+		Price:    price,
+		Status:   model.BidStatusPending,
+		Revision: 1,
+		History: []*model.BidRevision{
+			newBidRevision(1, model.BidRevisionTypeBid, sender, price, deadline, nil),
+		},
+		Messages:  make([]*model.BidMessage, 0),
 		Timestamp: strconv.FormatInt(time.Now().Unix(), 10),
 	}
-	_, err = collection.UpdateOne(context.TODO(), bson.M{"_id": id}, bson.M{
+	// the auction may have closed since it was read
+	pushed, err := collection.UpdateOne(context.TODO(), bson.M{
+		"_id":         id,
+		"status":      model.AuctionStatusOpen,
+		"bids.issuer": bson.M{"$ne": sender},
+	}, bson.M{
 		"$push": bson.M{"bids": bid},
+		"$inc":  bson.M{"bidcount": 1},
 	})
 	if err != nil {
 		return nil, errors.New("Unexpected error")
 	}
+	if pushed.MatchedCount == 0 {
+		return nil, errors.New("This auction is no longer accepting Bids")
+	}
 	return bid, nil
 }
 
@@ -167,61 +699,87 @@ func (db *auctionRepository) DeleteBid(sender, auctionID, bidID string) (bool, e
 	if err != nil {
 		return false, errors.New("Invalid auctionID")
 	}
-	result := collection.FindOne(context.TODO(), bson.M{"_id": id})
-	var auction model.Auction
-	err = result.Decode(&auction)
-	if err != nil {
-		return false, err
-	}
-	deleted := false
-	for i, b := range auction.Bids {
-		if b.ID == bidID && b.Issuer == sender && !b.Selected {
-			auction.Bids = append(auction.Bids[:i], auction.Bids[i+1:]...)
-			deleted = true
-			break
-		}
-	}
-	if !deleted {
+	result, err := collection.UpdateOne(context.TODO(), bson.M{
+		"_id": id,
+		"bids": bson.M{"$elemMatch": bson.M{
+			"id":       bidID,
+			"issuer":   sender,
+			"selected": bson.M{"$ne": true},
+		}},
+	}, bson.M{
+		"$pull": bson.M{"bids": bson.M{"id": bidID}},
+		"$inc":  bson.M{"bidcount": -1},
+	})
+	if err != nil || result.ModifiedCount == 0 {
 		return false, errors.New("Could not delete bid")
 	}
-	_, err = collection.UpdateOne(context.TODO(), bson.M{"_id": id}, bson.M{
-		"$set": bson.M{"bids": auction.Bids},
-	})
+	return true, nil
+}
+
+// AcceptBid awards the auction to one of its bids, at its latest agreed
+// revision, and rejects the others. Only an open or closed auction can be
+// awarded, so when two bids are accepted at the same time only the first one
+// wins. When revision is given, the bid is only accepted if it wasn't
+// revised since.
+func (db *auctionRepository) AcceptBid(sender, auctionID, bidID string, revision *int) (bool, error) {
+	id, err := primitive.ObjectIDFromHex(auctionID)
 	if err != nil {
-		return false, errors.New("Could not delete bid")
+		return false, errors.New("Invalid auctionID")
+	}
+	winner := bson.M{"id": bidID}
+	if revision != nil {
+		winner["revision"] = *revision
+	}
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{Filters: []interface{}{
+		bson.M{"winner.id": bidID},
+		bson.M{"other.id": bson.M{"$ne": bidID}},
+	}})
+	err = transitionAuction(db.client, id, model.AuctionStatusAwarded, bson.M{
+		"host": sender,
+		"bids": bson.M{"$elemMatch": winner},
+	}, bson.M{
+		"bids.$[winner].selected":     true,
+		"bids.$[winner].status":       model.BidStatusAccepted,
+		"bids.$[winner].counteroffer": nil,
+		"bids.$[other].selected":      false,
+		"bids.$[other].status":        model.BidStatusRejected,
+	}, errors.New("Could not accept bid, it may have been revised"), opts)
+	if err != nil {
+		return false, err
 	}
 	return true, nil
 }
 
-func (db *auctionRepository) AcceptBid(sender, auctionID, bidID string) (bool, error) {
+// UnacceptBid takes back the accepted bid of an auction, as long as its
+// payment didn't start, and reopens the auction to every bid
+func (db *auctionRepository) UnacceptBid(sender, auctionID, bidID string) (bool, error) {
 	id, err := primitive.ObjectIDFromHex(auctionID)
 	if err != nil {
 		return false, errors.New("Invalid auctionID")
 	}
-	collection := db.client.Collection(CollectionAuctions)
-	result := collection.FindOne(context.TODO(), bson.M{"_id": id})
 	var auction model.Auction
-	err = result.Decode(&auction)
+	err = db.client.Collection(CollectionAuctions).FindOne(context.TODO(), bson.M{"_id": id}).Decode(&auction)
 	if err != nil {
 		return false, errors.New("Auction not found")
 	}
-	if auction.Host != sender {
-		return false, errors.New("Unauthorized")
-	}
-	changed := false
-	for i, b := range auction.Bids {
-		if b.ID == bidID || !b.Selected {
-			auction.Bids[i].Selected = true
-			changed = true
-			break
-		}
-	}
-	if !changed {
-		return true, nil
+	deadline, err := parseUnixTime(auction.Deadline, "deadline")
+	if err != nil {
+		return false, err
 	}
-	_, err = collection.UpdateOne(context.TODO(), bson.M{"_id": id}, bson.M{
-		"$set": bson.M{"bids": auction.Bids},
-	})
+	// if the deadline passes in the meantime, AuctionJob closes it
+	status := model.AuctionStatusClosed
+	if deadline > time.Now().Unix() {
+		status = model.AuctionStatusOpen
+	}
+	err = transitionAuction(db.client, id, status, bson.M{
+		"host":           sender,
+		"status":         model.AuctionStatusAwarded,
+		"paymentstarted": bson.M{"$ne": true},
+		"bids":           bson.M{"$elemMatch": bson.M{"id": bidID, "selected": true}},
+	}, bson.M{
+		"bids.$[].selected": false,
+		"bids.$[].status":   model.BidStatusPending,
+	}, errors.New("Could not unaccept bid"))
 	if err != nil {
 		return false, err
 	}
@@ -252,19 +810,7 @@ func (db *auctionRepository) AcceptedBids(sender string) ([]*model.FeedAuction,
 		if a.Host[0].Nickname != sender {
 			for _, b := range a.Bids {
 				if b.Issuer == sender && b.Selected {
-					auctionList = append(auctionList, &model.FeedAuction{
-						ID: a.ID,
-						Host: &model.FeedUser{
-							Name:     a.Host[0].Name,
-							Nickname: a.Host[0].Nickname,
-							Picture:  a.Host[0].Picture,
-						},
-						Bids:        a.Bids,
-						Deadline:    a.Deadline,
-						Description: a.Description,
-						Offer:       a.Offer,
-						Timestamp:   a.Timestamp,
-					})
+					auctionList = append(auctionList, newFeedAuctionModel(&a, sender))
 				}
 			}
 		}
@@ -272,29 +818,40 @@ func (db *auctionRepository) AcceptedBids(sender string) ([]*model.FeedAuction,
 	return auctionList, err
 }
 
+// BidPaymentLink starts the payment of the accepted bid. From then on the
+// bid can't be unaccepted.
 func (db *auctionRepository) BidPaymentLink(sender, auctionID, bidID string) (string, error) {
 	id, err := primitive.ObjectIDFromHex(auctionID)
 	if err != nil {
 		return "", errors.New("Invalid auctionID")
 	}
 	collection := db.client.Collection(CollectionAuctions)
-	result := collection.FindOne(context.TODO(), bson.M{"_id": id})
 	var auction model.Auction
-	err = result.Decode(&auction)
+	err = collection.FindOneAndUpdate(context.TODO(), bson.M{
+		"_id":    id,
+		"host":   sender,
+		"status": model.AuctionStatusAwarded,
+		"bids":   bson.M{"$elemMatch": bson.M{"id": bidID, "selected": true}},
+	}, bson.M{
+		"$set": bson.M{"paymentstarted": true},
+	}).Decode(&auction)
 	if err != nil {
-		return "", errors.New("Auction not found")
-	}
-	if auction.Host != sender {
-		return "", errors.New("Unauthorized")
+		err = collection.FindOne(context.TODO(), bson.M{"_id": id}).Decode(&auction)
+		switch {
+		case err != nil:
+			return "", errors.New("Auction not found")
+		case auction.Host != sender:
+			return "", errors.New("Unauthorized")
+		case auction.Status != model.AuctionStatusAwarded:
+			return "", errors.New("Only awarded auctions can be paid")
+		}
+		return "", errors.New("You need to accept this bid first")
 	}
-
 	for _, b := range auction.Bids {
 		if b.ID == bidID {
-			if !b.Selected {
-				return "", errors.New("You need to accept this bid first")
-			}
 			url, err := db.order.CreateOrder(auctionID, bidID, auction.Description, b.Price)
 			if err != nil {
+				db.clearPaymentStarted(auctionID, id)
 				return "", err
 			}
 			return url, nil
@@ -303,12 +860,60 @@ func (db *auctionRepository) BidPaymentLink(sender, auctionID, bidID string) (st
 	return "", errors.New("Could not generate link")
 }
 
+// clearPaymentStarted lets the bid of an auction be unaccepted again when
+// creating its first order failed
+func (db *auctionRepository) clearPaymentStarted(auctionID string, id primitive.ObjectID) {
+	count, err := db.client.Collection(CollectionPayments).CountDocuments(context.TODO(), bson.M{"auctionID": auctionID})
+	if err != nil || count > 0 {
+		return
+	}
+	db.client.Collection(CollectionAuctions).UpdateOne(context.TODO(), bson.M{"_id": id},
+		bson.M{"$set": bson.M{"paymentstarted": false}})
+}
+
+// migrateAuctionBudgets turns the offer of auctions created before budgets
+// existed into a budget range, and counts their bids
+func migrateAuctionBudgets(client *mongo.Database) error {
+	_, err := client.Collection(CollectionAuctions).UpdateMany(context.TODO(), bson.M{
+		"maxbudget": bson.M{"$exists": false},
+	}, []bson.M{{"$set": bson.M{
+		"minbudget":    "$offer",
+		"maxbudget":    "$offer",
+		"deliverydate": "$deadline",
+		"tags":         bson.A{},
+		"references":   bson.A{},
+	}}})
+	if err != nil {
+		return err
+	}
+	_, err = client.Collection(CollectionAuctions).UpdateMany(context.TODO(), bson.M{
+		"bidcount": bson.M{"$exists": false},
+	}, []bson.M{{"$set": bson.M{
+		"bidcount": bson.M{"$size": bson.M{"$ifNull": bson.A{"$bids", bson.A{}}}},
+	}}})
+	return err
+}
+
 // NewAuctionRepository function
 func NewAuctionRepository() AuctionRepository {
 	client := newDatabaseClient()
+	client.Collection(CollectionAuctions).Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "deadline", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "maxbudget", Value: -1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "bidcount", Value: -1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "tags", Value: 1}, {Key: "status", Value: 1}, {Key: "deadline", Value: 1}}},
+	})
+	migrateAuctionStatus(client)
+	migrateAuctionBudgets(client)
+	migrateBidStatus(client)
+	migrateBidHistory(client)
 	order := NewOrderRepository()
+	awsSession := service.NewAwsService()
 	return &auctionRepository{
 		client,
 		order,
+		awsSession,
 	}
 }
@@ -0,0 +1,279 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// stubOrderRepository creates orders without reaching PayPal
+type stubOrderRepository struct {
+	OrderRepository
+}
+
+func (stubOrderRepository) CreateOrder(auctionID, bidID, description string, price float64) (string, error) {
+	return "https://example.com/pay/" + auctionID + "/" + bidID, nil
+}
+
+func newTestAuctionRepository(t *testing.T) *auctionRepository {
+	return &auctionRepository{client: testDatabase(t), order: stubOrderRepository{}}
+}
+
+// insertTestAuction stores an auction of "host" with a pending bid for every
+// bidder and returns its id and the ids of the bids
+func insertTestAuction(t *testing.T, db *auctionRepository, status model.AuctionStatus, bidders ...string) (string, []string) {
+	now := time.Now().Unix()
+	deadline := strconv.FormatInt(now+24*60*60, 10)
+	bids := make([]*model.Bid, 0, len(bidders))
+	ids := make([]string, 0, len(bidders))
+	for _, bidder := range bidders {
+		bid := &model.Bid{
+			ID:        primitive.NewObjectID().Hex(),
+			Issuer:    bidder,
+			Deadline:  deadline,
+			Price:     10,
+			Status:    model.BidStatusPending,
+			Revision:  1,
+			History:   []*model.BidRevision{newBidRevision(1, model.BidRevisionTypeBid, bidder, 10, deadline, nil)},
+			Messages:  make([]*model.BidMessage, 0),
+			Timestamp: strconv.FormatInt(now, 10),
+		}
+		bids = append(bids, bid)
+		ids = append(ids, bid.ID)
+	}
+	result, err := db.client.Collection(CollectionAuctions).InsertOne(context.TODO(), bson.M{
+		"host":      "host",
+		"offer":     10,
+		"bids":      bids,
+		"bidcount":  len(bids),
+		"timestamp": strconv.FormatInt(now, 10),
+		"deadline":  deadline,
+		"status":    status,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result.InsertedID.(primitive.ObjectID).Hex(), ids
+}
+
+func findTestAuction(t *testing.T, db *auctionRepository, auctionID string) *model.Auction {
+	id, _ := primitive.ObjectIDFromHex(auctionID)
+	var auction model.Auction
+	if err := db.client.Collection(CollectionAuctions).FindOne(context.TODO(), bson.M{"_id": id}).Decode(&auction); err != nil {
+		t.Fatal(err)
+	}
+	return &auction
+}
+
+// acceptTestBid awards an auction to a bid and fails the test when it can't
+func acceptTestBid(t *testing.T, db *auctionRepository, auctionID, bidID string) {
+	if ok, err := db.AcceptBid("host", auctionID, bidID, nil); !ok || err != nil {
+		t.Fatalf("accept failed: %v", err)
+	}
+}
+
+// runConcurrently starts every call at the same time and waits for them
+func runConcurrently(calls ...func()) {
+	var start, done sync.WaitGroup
+	start.Add(1)
+	for _, call := range calls {
+		done.Add(1)
+		go func(call func()) {
+			defer done.Done()
+			start.Wait()
+			call()
+		}(call)
+	}
+	start.Done()
+	done.Wait()
+}
+
+func TestAcceptBidConcurrently(t *testing.T) {
+	db := newTestAuctionRepository(t)
+	bidders := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}
+	for round := 0; round < 5; round++ {
+		auctionID, bidIDs := insertTestAuction(t, db, model.AuctionStatusOpen, bidders...)
+		var mutex sync.Mutex
+		accepted := make([]string, 0)
+		calls := make([]func(), 0, len(bidIDs))
+		for _, bidID := range bidIDs {
+			bidID := bidID
+			calls = append(calls, func() {
+				if ok, _ := db.AcceptBid("host", auctionID, bidID, nil); ok {
+					mutex.Lock()
+					accepted = append(accepted, bidID)
+					mutex.Unlock()
+				}
+			})
+		}
+		runConcurrently(calls...)
+		if len(accepted) != 1 {
+			t.Fatalf("round %d: %d bids were accepted, want 1", round, len(accepted))
+		}
+		auction := findTestAuction(t, db, auctionID)
+		if auction.Status != model.AuctionStatusAwarded {
+			t.Errorf("round %d: status = %s, want %s", round, auction.Status, model.AuctionStatusAwarded)
+		}
+		for _, b := range auction.Bids {
+			winner := b.ID == accepted[0]
+			switch {
+			case winner && (b.Status != model.BidStatusAccepted || !b.Selected):
+				t.Errorf("round %d: winner %s is %s, selected %v", round, b.ID, b.Status, b.Selected)
+			case !winner && (b.Status != model.BidStatusRejected || b.Selected):
+				t.Errorf("round %d: bid %s is %s, selected %v", round, b.ID, b.Status, b.Selected)
+			}
+		}
+	}
+}
+
+func TestAcceptBidRevision(t *testing.T) {
+	db := newTestAuctionRepository(t)
+	auctionID, bidIDs := insertTestAuction(t, db, model.AuctionStatusOpen, "a")
+	stale := 0
+	if ok, err := db.AcceptBid("host", auctionID, bidIDs[0], &stale); ok || err == nil {
+		t.Error("bid was accepted at a revision it isn't at")
+	}
+	if ok, err := db.AcceptBid("a", auctionID, bidIDs[0], nil); ok || err == nil {
+		t.Error("bidder accepted their own bid")
+	}
+	current := 1
+	if ok, err := db.AcceptBid("host", auctionID, bidIDs[0], &current); !ok || err != nil {
+		t.Errorf("accept at the current revision failed: %v", err)
+	}
+}
+
+func TestUnacceptBid(t *testing.T) {
+	db := newTestAuctionRepository(t)
+	auctionID, bidIDs := insertTestAuction(t, db, model.AuctionStatusOpen, "a", "b")
+	acceptTestBid(t, db, auctionID, bidIDs[0])
+	if ok, err := db.UnacceptBid("host", auctionID, bidIDs[1]); ok || err == nil {
+		t.Error("a rejected bid was unaccepted")
+	}
+	if ok, err := db.UnacceptBid("host", auctionID, bidIDs[0]); !ok || err != nil {
+		t.Fatalf("unaccept failed: %v", err)
+	}
+	auction := findTestAuction(t, db, auctionID)
+	if auction.Status != model.AuctionStatusOpen {
+		t.Errorf("status = %s, want %s", auction.Status, model.AuctionStatusOpen)
+	}
+	for _, b := range auction.Bids {
+		if b.Status != model.BidStatusPending || b.Selected {
+			t.Errorf("bid %s is %s, selected %v", b.ID, b.Status, b.Selected)
+		}
+	}
+}
+
+func TestUnacceptBidAfterPaymentStarted(t *testing.T) {
+	db := newTestAuctionRepository(t)
+	auctionID, bidIDs := insertTestAuction(t, db, model.AuctionStatusOpen, "a", "b")
+	acceptTestBid(t, db, auctionID, bidIDs[0])
+	if _, err := db.BidPaymentLink("host", auctionID, bidIDs[0]); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := db.UnacceptBid("host", auctionID, bidIDs[0]); ok || err == nil {
+		t.Fatal("bid was unaccepted after its payment started")
+	}
+	auction := findTestAuction(t, db, auctionID)
+	if auction.Status != model.AuctionStatusAwarded {
+		t.Errorf("status = %s, want %s", auction.Status, model.AuctionStatusAwarded)
+	}
+	if !auction.Bids[0].Selected || auction.Bids[0].Status != model.BidStatusAccepted {
+		t.Errorf("accepted bid is %s, selected %v", auction.Bids[0].Status, auction.Bids[0].Selected)
+	}
+	if ok, err := db.CancelAuction("host", auctionID); ok || err == nil {
+		t.Error("auction was cancelled after its payment started")
+	}
+}
+
+// TestUnacceptBidWhilePaying checks that a bid is either unaccepted or paid
+// for when both happen at the same time, never both
+func TestUnacceptBidWhilePaying(t *testing.T) {
+	db := newTestAuctionRepository(t)
+	for round := 0; round < 10; round++ {
+		auctionID, bidIDs := insertTestAuction(t, db, model.AuctionStatusOpen, "a")
+		acceptTestBid(t, db, auctionID, bidIDs[0])
+		var unaccepted, paying bool
+		runConcurrently(func() {
+			unaccepted, _ = db.UnacceptBid("host", auctionID, bidIDs[0])
+		}, func() {
+			_, err := db.BidPaymentLink("host", auctionID, bidIDs[0])
+			paying = err == nil
+		})
+		if unaccepted == paying {
+			t.Fatalf("round %d: unaccepted %v, paying %v", round, unaccepted, paying)
+		}
+		auction := findTestAuction(t, db, auctionID)
+		if unaccepted && auction.Status != model.AuctionStatusOpen {
+			t.Errorf("round %d: unaccepted auction is %s", round, auction.Status)
+		}
+		if paying && auction.Status != model.AuctionStatusAwarded {
+			t.Errorf("round %d: paid auction is %s", round, auction.Status)
+		}
+	}
+}
+
+func TestCompleteAuction(t *testing.T) {
+	db := newTestAuctionRepository(t)
+	auctionID, _ := insertTestAuction(t, db, model.AuctionStatusDelivered, "a")
+	if ok, err := db.CompleteAuction("a", auctionID); ok || err == nil {
+		t.Error("auction was completed by someone else than its host")
+	}
+	if ok, err := db.CompleteAuction("host", auctionID); !ok || err != nil {
+		t.Fatalf("complete failed: %v", err)
+	}
+	if status := findTestAuction(t, db, auctionID).Status; status != model.AuctionStatusCompleted {
+		t.Errorf("status = %s, want %s", status, model.AuctionStatusCompleted)
+	}
+}
+
+// TestCompleteDisputedAuction checks that the host can't settle a dispute on
+// their own
+func TestCompleteDisputedAuction(t *testing.T) {
+	db := newTestAuctionRepository(t)
+	auctionID, _ := insertTestAuction(t, db, model.AuctionStatusDisputed, "a")
+	if ok, err := db.CompleteAuction("host", auctionID); ok || err == nil {
+		t.Fatal("disputed auction was completed by its host")
+	}
+	if status := findTestAuction(t, db, auctionID).Status; status != model.AuctionStatusDisputed {
+		t.Fatalf("status = %s, want %s", status, model.AuctionStatusDisputed)
+	}
+	if ok, err := db.ResolveAuctionDispute(auctionID, model.AuctionStatusCompleted); !ok || err != nil {
+		t.Fatalf("resolve failed: %v", err)
+	}
+	if status := findTestAuction(t, db, auctionID).Status; status != model.AuctionStatusCompleted {
+		t.Errorf("status = %s, want %s", status, model.AuctionStatusCompleted)
+	}
+}
+
+func TestSendBidMessage(t *testing.T) {
+	db := newTestAuctionRepository(t)
+	auctionID, bidIDs := insertTestAuction(t, db, model.AuctionStatusOpen, "a", "b")
+	if _, err := db.SendBidMessage("b", auctionID, bidIDs[0], "hello"); err == nil {
+		t.Error("message was sent on someone else's bid")
+	}
+	if _, err := db.SendBidMessage("host", auctionID, bidIDs[0], "hello"); err != nil {
+		t.Fatal(err)
+	}
+	acceptTestBid(t, db, auctionID, bidIDs[0])
+	if _, err := db.SendBidMessage("a", auctionID, bidIDs[0], "thanks"); err != nil {
+		t.Errorf("message on the accepted bid failed: %v", err)
+	}
+	if _, err := db.SendBidMessage("b", auctionID, bidIDs[1], "hello"); err == nil {
+		t.Error("message was sent on a rejected bid")
+	}
+	if ok, err := db.CancelAuction("host", auctionID); !ok || err != nil {
+		t.Fatalf("cancel failed: %v", err)
+	}
+	if _, err := db.SendBidMessage("a", auctionID, bidIDs[0], "hello"); err == nil {
+		t.Error("message was sent on a cancelled auction")
+	}
+	if messages := findTestAuction(t, db, auctionID).Bids[0].Messages; len(messages) != 2 {
+		t.Errorf("%d messages, want 2", len(messages))
+	}
+}
@@ -3,8 +3,9 @@ package repository
 import (
 	"context"
 	"crypto/sha256"
+	"crypto/subtle"
 	"errors"
-	"math/rand"
+	"fmt"
 	"os"
 	"time"
 
@@ -12,40 +13,56 @@ import (
 	"github.com/eaemenkkstudios/cancanvas-backend/service"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // AuthRepository interface
 type AuthRepository interface {
-	Login(username, password string) (*model.Login, error)
-	ResetPassword(sender, hash, newPassword string) (bool, error)
-	SendForgotPasswordEmail(user string) (bool, error)
+	Login(ctx context.Context, username, password string) (*model.Login, error)
+	ResetPassword(token, newPassword string) (bool, error)
+	SendForgotPasswordEmail(ctx context.Context, user string) (bool, error)
+	EnableTwoFactor(sender string) (*model.TwoFactorSetup, error)
+	ConfirmTwoFactor(sender, code string) ([]string, error)
+	DisableTwoFactor(sender, code string) (bool, error)
+	VerifyTwoFactor(ctx context.Context, challenge, code string) (*model.Login, error)
+	LoginWithProvider(provider, code, nonce string) (*model.Login, error)
+	CompleteProviderSignup(token, nickname string) (*model.Login, error)
+	LinkProvider(sender, provider, code, nonce string) (bool, error)
 }
 
 type authRespository struct {
-	client *mongo.Database
+	client   *mongo.Database
+	sessions SessionRepository
+	totp     service.TOTPService
+	throttle *Throttle
+	oidc     service.OIDCService
+	mailer   service.MailerService
+	hasher   service.PasswordHasher
 }
 
 // Password struct
 type Password struct {
-	Hash string `json:"hash"`
-	Salt string `json:"salt"`
+	Hash      string `json:"hash"`
+	Salt      string `json:"salt"`
+	Algorithm string `json:"algorithm"`
 }
 
-var letters = []byte("*_#$-&abcdefghijklmnopqrstuvwxyz")
-
-func randSeq(n int) string {
-	rand.Seed(time.Now().Unix())
-	b := make([]byte, n)
-	for i := range b {
-		b[i] = letters[rand.Intn(len(letters))]
-	}
-	return string(b)
+// PasswordReset struct
+type PasswordReset struct {
+	ID        string    `bson:"_id"`
+	User      string    `bson:"user"`
+	Used      bool      `bson:"used"`
+	CreatedAt time.Time `bson:"createdat"`
+	ExpiresAt time.Time `bson:"expiresat"`
 }
 
-// GetSalt function
-func GetSalt() string {
-	return randSeq(0x20)
-}
+// PasswordResetTTL is how long a password reset link stays valid
+const PasswordResetTTL = time.Hour
+
+// AlgorithmLegacySHA256 identifies passwords hashed before PasswordHasher existed
+const AlgorithmLegacySHA256 = "sha256"
+
+var letters = []byte("*_#$-&abcdefghijklmnopqrstuvwxyz")
 
 // GetHash function
 func GetHash(salt, password string) string {
@@ -60,61 +77,178 @@ func GetHash(salt, password string) string {
 }
 
 // GeneratePassword function
-func GeneratePassword(password string) Password {
-	salt := GetSalt()
+func GeneratePassword(hasher service.PasswordHasher, password string) (Password, error) {
+	hash, err := hasher.Hash(password)
+	if err != nil {
+		return Password{}, err
+	}
 	return Password{
-		Salt: salt,
-		Hash: GetHash(salt, password),
+		Hash:      hash,
+		Algorithm: hasher.Algorithm(),
+	}, nil
+}
+
+// CheckPassword function
+func CheckPassword(stored Password, password string) bool {
+	if stored.Hash == "" {
+		return false
+	}
+	if stored.Algorithm == "" || stored.Algorithm == AlgorithmLegacySHA256 {
+		hash := GetHash(stored.Salt, password)
+		return subtle.ConstantTimeCompare([]byte(hash), []byte(stored.Hash)) == 1
 	}
+	hasher, err := service.GetPasswordHasher(stored.Algorithm)
+	if err != nil {
+		return false
+	}
+	return hasher.Compare(stored.Hash, password)
+}
+
+// NeedsRehash function
+func NeedsRehash(hasher service.PasswordHasher, stored Password) bool {
+	return stored.Algorithm != hasher.Algorithm()
 }
 
-func (db *authRespository) Login(username, password string) (*model.Login, error) {
+// clientKey builds a throttle key from the client address middleware.GraphQLHandler
+// stored in the context, or returns an empty key when the address is unknown
+func clientKey(ctx context.Context, prefix string) string {
+	ip := fmt.Sprintf("%v", ctx.Value("ip"))
+	if ip == "<nil>" || ip == "" {
+		return ""
+	}
+	return prefix + ip
+}
+
+// hitLogin counts a login attempt against the account and the client address
+// before the credentials are compared, so concurrent guesses can't slip past
+// the limit. It fails once either of them is over its limit.
+func (db *authRespository) hitLogin(accountKey, ipKey string) error {
+	if err := db.throttle.Hit(accountKey, LoginAccountPolicy); err != nil {
+		return err
+	}
+	return db.throttle.Hit(ipKey, LoginIPPolicy)
+}
+
+// loginSucceeded clears the account's attempts and gives the attempt back
+// to the client address, which may be shared by other users
+func (db *authRespository) loginSucceeded(accountKey, ipKey string) {
+	db.throttle.Reset(accountKey)
+	db.throttle.Release(ipKey)
+}
+
+func (db *authRespository) Login(ctx context.Context, username, password string) (*model.Login, error) {
+	accountKey := "login:account:" + username
+	ipKey := clientKey(ctx, "login:ip:")
+	if err := db.hitLogin(accountKey, ipKey); err != nil {
+		return nil, err
+	}
 	collection := db.client.Collection(CollectionUsers)
 	result := collection.FindOne(context.TODO(), bson.M{"_id": username})
 	var user *UserSchema
 	err := result.Decode(&user)
-	if err != nil {
-		return nil, errors.New("Unauthorized")
-	}
-	if pass := GetHash(user.Password.Salt, password); pass == user.Password.Hash {
-		token := service.NewJWTService().GenerateToken(username, false)
-		if user.First {
-			collection.UpdateOne(context.TODO(), bson.M{"_id": username}, bson.M{
-				"$set": bson.M{"first": false},
-			})
+	if err == nil && CheckPassword(user.Password, password) {
+		db.loginSucceeded(accountKey, ipKey)
+		if NeedsRehash(db.hasher, user.Password) {
+			if upgraded, err := GeneratePassword(db.hasher, password); err == nil {
+				collection.UpdateOne(context.TODO(), bson.M{"_id": username}, bson.M{
+					"$set": bson.M{"password": upgraded},
+				})
+			}
 		}
+		return db.startLogin(user)
+	}
+	return nil, errors.New("Unauthorized")
+}
+
+// startLogin asks for the second factor when the user enabled it, or opens a session otherwise
+func (db *authRespository) startLogin(user *UserSchema) (*model.Login, error) {
+	if user.TwoFactor.Enabled {
+		challenge := service.NewJWTService().GenerateTwoFactorToken(user.Nickname)
 		return &model.Login{
-			Token: token,
-			First: user.First,
+			TwoFactorRequired: true,
+			Challenge:         &challenge,
 		}, nil
 	}
-	return nil, errors.New("Unauthorized")
+	return db.completeLogin(user)
 }
 
-func (db *authRespository) ResetPassword(sender, hash, newPassword string) (bool, error) {
-	collection := db.client.Collection(CollectionUsers)
-	result := collection.FindOne(context.TODO(), bson.M{"_id": sender})
-	var u UserSchema
-	err := result.Decode(&u)
+// completeLogin opens a session for a user whose credentials were already checked
+func (db *authRespository) completeLogin(user *UserSchema) (*model.Login, error) {
+	login, err := db.sessions.CreateSession(user.Nickname)
 	if err != nil {
-		return false, errors.New("Could not reset password")
+		return nil, err
+	}
+	if user.First {
+		db.client.Collection(CollectionUsers).UpdateOne(context.TODO(), bson.M{"_id": user.Nickname}, bson.M{
+			"$set": bson.M{"first": false},
+		})
+	}
+	login.First = user.First
+	return login, nil
+}
+
+func (db *authRespository) ResetPassword(token, newPassword string) (bool, error) {
+	collection := db.client.Collection(CollectionPasswordResets)
+	result := collection.FindOneAndUpdate(context.TODO(), bson.M{
+		"_id":       hashToken(token),
+		"used":      false,
+		"expiresat": bson.M{"$gt": time.Now()},
+	}, bson.M{
+		"$set": bson.M{"used": true},
+	})
+	var reset PasswordReset
+	err := result.Decode(&reset)
+	if err != nil {
+		return false, errors.New("Invalid or expired token")
 	}
-	if hash != u.Password.Hash {
-		println(hash)
-		println(u.Password.Hash)
-		return false, errors.New("Invalid token")
+	password, err := GeneratePassword(db.hasher, newPassword)
+	if err != nil {
+		return false, errors.New("Could not reset password")
 	}
-	password := GeneratePassword(newPassword)
-	_, err = collection.UpdateOne(context.TODO(), bson.M{"_id": sender}, bson.M{
+	collection = db.client.Collection(CollectionUsers)
+	_, err = collection.UpdateOne(context.TODO(), bson.M{"_id": reset.User}, bson.M{
 		"$set": bson.M{"password": password},
 	})
 	if err != nil {
 		return false, err
 	}
+	db.sessions.RevokeAllSessions(reset.User)
+	db.throttle.Reset("login:account:" + reset.User)
 	return true, nil
 }
 
-func (db *authRespository) SendForgotPasswordEmail(user string) (bool, error) {
+// newPasswordReset replaces the pending password reset of user and returns
+// its token
+func newPasswordReset(client *mongo.Database, user string) (string, error) {
+	token, err := newOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+	resets := client.Collection(CollectionPasswordResets)
+	_, err = resets.DeleteMany(context.TODO(), bson.M{"user": user})
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	_, err = resets.InsertOne(context.TODO(), &PasswordReset{
+		ID:        hashToken(token),
+		User:      user,
+		CreatedAt: now,
+		ExpiresAt: now.Add(PasswordResetTTL),
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (db *authRespository) SendForgotPasswordEmail(ctx context.Context, user string) (bool, error) {
+	if err := db.throttle.Hit(clientKey(ctx, "reset:ip:"), ResetIPPolicy); err != nil {
+		return false, err
+	}
+	if err := db.throttle.Hit("reset:account:"+user, ResetEmailPolicy); err != nil {
+		return false, err
+	}
 	collection := db.client.Collection(CollectionUsers)
 	result := collection.FindOne(context.TODO(), bson.M{"_id": user})
 	var u UserSchema
@@ -122,14 +256,23 @@ func (db *authRespository) SendForgotPasswordEmail(user string) (bool, error) {
 	if err != nil {
 		return false, errors.New("User not found")
 	}
-	token := service.NewJWTService().GenerateResetPasswordToken(user, u.Password.Hash)
-	serverURL := os.Getenv("SERVER_URL")
-	err = service.NewMailerService().SendMail(u.Email, "Hi,\n\n"+
-		"A password reset was request to the account associated with the cdias900@gmail.com email address, click the link bellow to change your password:\n"+
-		serverURL+"/resetpassword?token="+token+"\n"+
-		"If you didn't request this change, please ignore this email.\n\n"+
-		"Cancavas Team\n",
-	)
+	if !u.EmailVerified {
+		return false, errors.New("The email address of this account was never verified")
+	}
+	token, err := newPasswordReset(db.client, user)
+	if err != nil {
+		return false, errors.New("Could not send email")
+	}
+	err = db.mailer.Send(&service.Mail{
+		To:       u.Email,
+		Locale:   u.Locale,
+		Template: service.MailPasswordReset,
+		Data: map[string]string{
+			"Name":  u.Name,
+			"Email": u.Email,
+			"Link":  os.Getenv("SERVER_URL") + "/resetpassword?token=" + token,
+		},
+	})
 	if err != nil {
 		return false, errors.New("Could not send email")
 	}
@@ -139,7 +282,24 @@ func (db *authRespository) SendForgotPasswordEmail(user string) (bool, error) {
 // NewAuthRepository function
 func NewAuthRepository() AuthRepository {
 	client := newDatabaseClient()
+	sessions := NewSessionRepository()
+	totp := service.NewTOTPService()
+	throttle := NewThrottle(NewMongoAttemptStore(client))
+	client.Collection(CollectionPasswordResets).Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.M{"user": 1}},
+		{Keys: bson.M{"expiresat": 1}, Options: options.Index().SetExpireAfterSeconds(0)},
+	})
+	identityIndex(client)
+	oidc := service.NewOIDCService()
+	mailer := NewMailOutbox()
+	hasher := service.NewPasswordHasher()
 	return &authRespository{
 		client,
+		sessions,
+		totp,
+		throttle,
+		oidc,
+		mailer,
+		hasher,
 	}
 }
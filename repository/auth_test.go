@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/service"
+)
+
+func testHasher(t *testing.T, algorithm string) service.PasswordHasher {
+	hasher, err := service.GetPasswordHasher(algorithm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hasher
+}
+
+func TestGeneratePassword(t *testing.T) {
+	for _, algorithm := range []string{service.AlgorithmArgon2id, service.AlgorithmBcrypt} {
+		password, err := GeneratePassword(testHasher(t, algorithm), "secret")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if password.Algorithm != algorithm || password.Salt != "" {
+			t.Errorf("%s: password = %+v", algorithm, password)
+		}
+		if !CheckPassword(password, "secret") {
+			t.Errorf("%s: password doesn't match", algorithm)
+		}
+		if CheckPassword(password, "Secret") {
+			t.Errorf("%s: wrong password matches", algorithm)
+		}
+	}
+}
+
+func TestCheckPasswordLegacy(t *testing.T) {
+	for _, algorithm := range []string{"", AlgorithmLegacySHA256} {
+		stored := Password{Hash: GetHash("salt", "secret"), Salt: "salt", Algorithm: algorithm}
+		if !CheckPassword(stored, "secret") {
+			t.Errorf("%q: legacy password doesn't match", algorithm)
+		}
+		if CheckPassword(stored, "other") {
+			t.Errorf("%q: wrong legacy password matches", algorithm)
+		}
+		stored.Salt = "other"
+		if CheckPassword(stored, "secret") {
+			t.Errorf("%q: legacy password matches with another salt", algorithm)
+		}
+	}
+}
+
+func TestCheckPasswordInvalid(t *testing.T) {
+	tests := map[string]Password{
+		"empty":             {},
+		"empty hash":        {Salt: "salt", Algorithm: AlgorithmLegacySHA256},
+		"unknown algorithm": {Hash: GetHash("", "secret"), Algorithm: "md5"},
+	}
+	for name, stored := range tests {
+		if CheckPassword(stored, "secret") {
+			t.Errorf("%s: password matches", name)
+		}
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	argon2id := testHasher(t, service.AlgorithmArgon2id)
+	bcrypt := testHasher(t, service.AlgorithmBcrypt)
+	tests := []struct {
+		hasher service.PasswordHasher
+		stored string
+		want   bool
+	}{
+		{argon2id, "", true},
+		{argon2id, AlgorithmLegacySHA256, true},
+		{argon2id, service.AlgorithmBcrypt, true},
+		{argon2id, service.AlgorithmArgon2id, false},
+		{bcrypt, service.AlgorithmArgon2id, true},
+		{bcrypt, service.AlgorithmBcrypt, false},
+	}
+	for _, test := range tests {
+		if got := NeedsRehash(test.hasher, Password{Algorithm: test.stored}); got != test.want {
+			t.Errorf("NeedsRehash(%s, %q) = %v, want %v", test.hasher.Algorithm(), test.stored, got, test.want)
+		}
+	}
+}
+
+// TestLoginConcurrently checks that concurrent guesses are counted before
+// the password is compared, so only the free attempts are ever compared
+func TestLoginConcurrently(t *testing.T) {
+	db := newTestAuthRepository(t, nil)
+	password, err := GeneratePassword(testHasher(t, service.AlgorithmBcrypt), "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.client.Collection(CollectionUsers).InsertOne(context.TODO(), &UserSchema{Nickname: "user", Password: password}); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.WithValue(context.Background(), "ip", "127.0.0.1")
+	var mutex sync.Mutex
+	compared := 0
+	calls := make([]func(), 4*LoginAccountPolicy.MaxAttempts)
+	for i := range calls {
+		calls[i] = func() {
+			if _, err := db.Login(ctx, "user", "wrong"); err != nil && err.Error() == "Unauthorized" {
+				mutex.Lock()
+				compared++
+				mutex.Unlock()
+			}
+		}
+	}
+	runConcurrently(calls...)
+	if compared != LoginAccountPolicy.MaxAttempts {
+		t.Errorf("%d passwords were compared, want %d", compared, LoginAccountPolicy.MaxAttempts)
+	}
+	if _, err := db.Login(ctx, "user", "secret"); err == nil {
+		t.Error("locked out account logged in")
+	}
+}
+
+func TestLoginResetsThrottle(t *testing.T) {
+	db := newTestAuthRepository(t, nil)
+	db.hasher = testHasher(t, service.AlgorithmBcrypt)
+	password, err := GeneratePassword(db.hasher, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.client.Collection(CollectionUsers).InsertOne(context.TODO(), &UserSchema{Nickname: "user", Password: password}); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.WithValue(context.Background(), "ip", "127.0.0.1")
+	for round := 0; round < 3; round++ {
+		for i := 0; i < LoginAccountPolicy.MaxAttempts-1; i++ {
+			if _, err := db.Login(ctx, "user", "wrong"); err == nil || err.Error() != "Unauthorized" {
+				t.Fatalf("round %d, attempt %d: %v", round, i+1, err)
+			}
+		}
+		if _, err := db.Login(ctx, "user", "secret"); err != nil {
+			t.Fatalf("round %d: %v", round, err)
+		}
+	}
+}
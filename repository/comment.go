@@ -0,0 +1,396 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MaxCommentDepth is how deeply replies can be nested
+const MaxCommentDepth = 5
+
+// CommentSchema struct
+type CommentSchema struct {
+	ID     primitive.ObjectID  `bson:"_id,omitempty"`
+	Post   primitive.ObjectID  `bson:"post"`
+	Parent *primitive.ObjectID `bson:"parent"`
+	// Ancestors lists the comments above this one, the top-level one first
+	Ancestors  []primitive.ObjectID `bson:"ancestors"`
+	PostAuthor string               `bson:"postauthor"`
+	Author     string               `bson:"author"`
+	Text       string               `bson:"text"`
+	LikeCount  int                  `bson:"likecount"`
+	Likes      []string             `bson:"likes"`
+	Hashtags   []string             `bson:"hashtags"`
+	Mentions   []string             `bson:"mentions"`
+	ReplyCount int                  `bson:"replycount"`
+	Timestamp  string               `bson:"timestamp"`
+	EditedAt   *string              `bson:"editedat,omitempty"`
+}
+
+// findCommentedPost loads the fields of a post its comments depend on
+func (db *postRepository) findCommentedPost(postID string) (*model.Post, primitive.ObjectID, error) {
+	id, err := primitive.ObjectIDFromHex(postID)
+	if err != nil {
+		return nil, id, errors.New("Invalid postID")
+	}
+	var post model.Post
+	err = db.client.Collection(CollectionPosts).FindOne(context.TODO(), bson.M{"_id": id},
+		options.FindOne().SetProjection(bson.M{"author": 1, "timestamp": 1, "hashtags": 1})).Decode(&post)
+	if err != nil {
+		return nil, id, errors.New("Post not found")
+	}
+	return &post, id, nil
+}
+
+// GetComments pages through the top-level comments of a post, or the
+// replies to parentID, oldest first
+func (db *postRepository) GetComments(sender, postID string, parentID *string, first *int, after *string) (*model.PostCommentConnection, error) {
+	size, err := pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+	c, err := decodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	id, err := primitive.ObjectIDFromHex(postID)
+	if err != nil {
+		return nil, errors.New("Invalid postID")
+	}
+	filter := bson.M{"post": id, "parent": nil}
+	if parentID != nil {
+		parent, err := primitive.ObjectIDFromHex(*parentID)
+		if err != nil {
+			return nil, errors.New("Invalid parentID")
+		}
+		filter["parent"] = parent
+	}
+	if c != nil {
+		cursorID, err := primitive.ObjectIDFromHex(c.ID)
+		if err != nil {
+			return nil, errors.New("Invalid cursor")
+		}
+		filter = bson.M{"$and": []bson.M{filter, keysetAfterAscending("timestamp", c.Key, cursorID)}}
+	}
+	pipeline := append(mongo.Pipeline{
+		bson.D{{Key: "$match", Value: filter}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "timestamp", Value: 1}, {Key: "_id", Value: 1}}}},
+		bson.D{{Key: "$limit", Value: size + 1}},
+	}, likedBy(sender)...)
+	ctx := context.TODO()
+	cursor, err := db.client.Collection(CollectionComments).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, errors.New("Could not load comments")
+	}
+	defer cursor.Close(ctx)
+	comments := make([]*likedComment, 0)
+	authors := make([]string, 0)
+	for cursor.Next(ctx) {
+		var comment likedComment
+		if err := cursor.Decode(&comment); err != nil {
+			return nil, err
+		}
+		comments = append(comments, &comment)
+		authors = append(authors, comment.Author)
+	}
+	hasNextPage := len(comments) > size
+	if hasNextPage {
+		comments = comments[:size]
+	}
+	users := make(map[string]*UserSchema)
+	if len(authors) > 0 {
+		cursor, err := db.client.Collection(CollectionUsers).Find(ctx, bson.M{"_id": bson.M{"$in": authors}},
+			options.Find().SetProjection(bson.M{"name": 1, "picture": 1}))
+		if err != nil {
+			return nil, errors.New("Could not load comments")
+		}
+		defer cursor.Close(ctx)
+		for cursor.Next(ctx) {
+			var u UserSchema
+			if err := cursor.Decode(&u); err != nil {
+				return nil, err
+			}
+			users[u.Nickname] = &u
+		}
+	}
+	connection := &model.PostCommentConnection{
+		Edges: make([]*model.PostCommentEdge, 0),
+	}
+	for _, comment := range comments {
+		u, ok := users[comment.Author]
+		if !ok {
+			u = &UserSchema{Nickname: comment.Author}
+		}
+		connection.Edges = append(connection.Edges, &model.PostCommentEdge{
+			Cursor: encodeCursor(comment.Timestamp, comment.ID.Hex()),
+			Node:   newPostCommentModel(comment, u),
+		})
+	}
+	connection.PageInfo = newPageInfo(c, hasNextPage, len(connection.Edges), func(i int) string {
+		return connection.Edges[i].Cursor
+	})
+	return connection, nil
+}
+
+// likedComment is a comment as seen by a viewer, see likedBy
+type likedComment struct {
+	CommentSchema `bson:",inline"`
+	Liked         bool `bson:"liked"`
+}
+
+func newPostCommentModel(comment *likedComment, author *UserSchema) *model.PostComment {
+	var parentID *string
+	if comment.Parent != nil {
+		parent := comment.Parent.Hex()
+		parentID = &parent
+	}
+	return &model.PostComment{
+		ID:       comment.ID.Hex(),
+		PostID:   comment.Post.Hex(),
+		ParentID: parentID,
+		Author: &model.FeedUser{
+			Name:     author.Name,
+			Nickname: author.Nickname,
+			Picture:  author.Picture,
+		},
+		Likes:      comment.LikeCount,
+		Liked:      comment.Liked,
+		Mentions:   comment.Mentions,
+		ReplyCount: comment.ReplyCount,
+		Text:       comment.Text,
+		Timestamp:  comment.Timestamp,
+		EditedAt:   comment.EditedAt,
+	}
+}
+
+// CommentOnPost adds a comment to a post, or a reply to parentID
+func (db *postRepository) CommentOnPost(sender, postID, message string, parentID *string) (string, error) {
+	if message == "" {
+		return "", errors.New("Comment can't be empty")
+	}
+	post, id, err := db.findCommentedPost(postID)
+	if err != nil {
+		return "", err
+	}
+	comment := &CommentSchema{
+		Post:       id,
+		Ancestors:  make([]primitive.ObjectID, 0),
+		PostAuthor: post.Author,
+		Author:     sender,
+		Text:       message,
+		LikeCount:  0,
+		Likes:      make([]string, 0),
+		Hashtags:   ParseHashtags(message),
+		ReplyCount: 0,
+		Timestamp:  strconv.FormatInt(time.Now().Unix(), 10),
+	}
+	collection := db.client.Collection(CollectionComments)
+	if parentID != nil {
+		parentObjectID, err := primitive.ObjectIDFromHex(*parentID)
+		if err != nil {
+			return "", errors.New("Invalid parentID")
+		}
+		var parent CommentSchema
+		err = collection.FindOne(context.TODO(), bson.M{"_id": parentObjectID, "post": id},
+			options.FindOne().SetProjection(bson.M{"ancestors": 1})).Decode(&parent)
+		if err != nil {
+			return "", errors.New("Comment not found")
+		}
+		if len(parent.Ancestors)+1 >= MaxCommentDepth {
+			return "", errors.New("Replies can't be nested any deeper")
+		}
+		comment.Parent = &parent.ID
+		comment.Ancestors = append(parent.Ancestors, parent.ID)
+	}
+	comment.Mentions, err = parseMentions(db.client, message)
+	if err != nil {
+		return "", errors.New("Could not comment on post")
+	}
+	result, err := collection.InsertOne(context.TODO(), comment)
+	if err != nil {
+		return "", errors.New("Could not comment on post")
+	}
+	commentID := result.InsertedID.(primitive.ObjectID).Hex()
+	db.client.Collection(CollectionPosts).UpdateOne(context.TODO(), bson.M{"_id": id}, bson.M{
+		"$inc": bson.M{"comments.count": 1},
+	})
+	if comment.Parent != nil {
+		collection.UpdateOne(context.TODO(), bson.M{"_id": comment.Parent}, bson.M{
+			"$inc": bson.M{"replycount": 1},
+		})
+	}
+	syncHashtags(db.client, post)
+	db.notifications.NotifyMentions(sender, comment.Mentions, id, &commentID)
+	return commentID, nil
+}
+
+func (db *postRepository) EditComment(sender, postID, commentID, message string) (bool, error) {
+	if message == "" {
+		return false, errors.New("Comment can't be empty")
+	}
+	post, id, err := db.findCommentedPost(postID)
+	if err != nil {
+		return false, err
+	}
+	commentObjectID, err := primitive.ObjectIDFromHex(commentID)
+	if err != nil {
+		return false, errors.New("Invalid commentID")
+	}
+	mentions, err := parseMentions(db.client, message)
+	if err != nil {
+		return false, errors.New("Could not edit comment")
+	}
+	editedAt := strconv.FormatInt(time.Now().Unix(), 10)
+	// the comment as it was before the edit, to tell which mentions are new
+	var previous CommentSchema
+	err = db.client.Collection(CollectionComments).FindOneAndUpdate(context.TODO(), bson.M{
+		"_id":    commentObjectID,
+		"post":   id,
+		"author": sender,
+	}, bson.M{
+		"$set": bson.M{
+			"text":     message,
+			"hashtags": ParseHashtags(message),
+			"mentions": mentions,
+			"editedat": editedAt,
+		},
+	}).Decode(&previous)
+	if err != nil {
+		return false, errors.New("Comment not found")
+	}
+	db.notifications.RemoveMentions(missingFrom(previous.Mentions, mentions), id, &commentID)
+	db.notifications.NotifyMentions(sender, missingFrom(mentions, previous.Mentions), id, &commentID)
+	syncHashtags(db.client, post)
+	return true, nil
+}
+
+func (db *postRepository) DeleteComment(sender, postID, commentID string) (bool, error) {
+	return db.deleteComment(&sender, postID, commentID)
+}
+
+func (db *postRepository) RemoveComment(postID, commentID string) (bool, error) {
+	return db.deleteComment(nil, postID, commentID)
+}
+
+// deleteComment removes a comment and its replies on behalf of sender, or
+// unconditionally when sender is nil
+func (db *postRepository) deleteComment(sender *string, postID, commentID string) (bool, error) {
+	post, id, err := db.findCommentedPost(postID)
+	if err != nil {
+		return false, err
+	}
+	commentObjectID, err := primitive.ObjectIDFromHex(commentID)
+	if err != nil {
+		return false, errors.New("Invalid commentID")
+	}
+	collection := db.client.Collection(CollectionComments)
+	var comment CommentSchema
+	err = collection.FindOne(context.TODO(), bson.M{"_id": commentObjectID, "post": id}).Decode(&comment)
+	if err != nil {
+		return false, errors.New("Comment not found")
+	}
+	if sender != nil && comment.Author != *sender && post.Author != *sender {
+		return false, errors.New("Unauthorized")
+	}
+	thread := bson.M{"$or": []bson.M{{"_id": commentObjectID}, {"ancestors": commentObjectID}}}
+	ctx := context.TODO()
+	cursor, err := collection.Find(ctx, thread, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return false, err
+	}
+	deleted := make([]string, 0)
+	for cursor.Next(ctx) {
+		var c CommentSchema
+		if err := cursor.Decode(&c); err != nil {
+			cursor.Close(ctx)
+			return false, err
+		}
+		deleted = append(deleted, c.ID.Hex())
+	}
+	cursor.Close(ctx)
+	result, err := collection.DeleteMany(ctx, thread)
+	if err != nil {
+		return false, err
+	}
+	if result.DeletedCount == 0 {
+		return false, errors.New("Comment not found")
+	}
+	db.client.Collection(CollectionPosts).UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$inc": bson.M{"comments.count": -result.DeletedCount},
+	})
+	if comment.Parent != nil {
+		collection.UpdateOne(ctx, bson.M{"_id": comment.Parent}, bson.M{
+			"$inc": bson.M{"replycount": -1},
+		})
+	}
+	db.notifications.RemoveComments(deleted)
+	syncHashtags(db.client, post)
+	return true, nil
+}
+
+// migrateComments moves the comments embedded in older post documents into
+// the comments collection, keeping their IDs
+func migrateComments(client *mongo.Database) error {
+	ctx := context.TODO()
+	posts := client.Collection(CollectionPosts)
+	cursor, err := posts.Find(ctx, bson.M{"comments.list.0": bson.M{"$exists": true}},
+		options.Find().SetProjection(bson.M{"author": 1, "comments": 1}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var post model.Post
+		if err := cursor.Decode(&post); err != nil {
+			return err
+		}
+		id, err := primitive.ObjectIDFromHex(post.ID)
+		if err != nil {
+			continue
+		}
+		comments := make([]interface{}, 0, len(post.Comments.List))
+		for _, c := range post.Comments.List {
+			commentID, err := primitive.ObjectIDFromHex(c.ID)
+			if err != nil {
+				commentID = primitive.NewObjectID()
+			}
+			comment := &CommentSchema{
+				ID:         commentID,
+				Post:       id,
+				Ancestors:  make([]primitive.ObjectID, 0),
+				PostAuthor: post.Author,
+				Author:     c.Author,
+				Text:       c.Text,
+				LikeCount:  c.LikeCount,
+				Likes:      c.Likes,
+				Hashtags:   c.Hashtags,
+				Mentions:   c.Mentions,
+				Timestamp:  c.Timestamp,
+			}
+			if comment.Likes == nil {
+				comment.Likes = make([]string, 0)
+			}
+			comments = append(comments, comment)
+		}
+		// a migration interrupted after the insert is resumed without
+		// duplicating the comments
+		if err := insertIgnoringDuplicates(client.Collection(CollectionComments), comments); err != nil {
+			return err
+		}
+		_, err = posts.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+			"$set": bson.M{"comments.list": make([]*model.Comment, 0), "comments.count": len(comments)},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,25 +3,30 @@ package repository
 import (
 	"context"
 	"errors"
+	"sort"
+	"strconv"
 
 	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // FeedRepository interface
 type FeedRepository interface {
-	GetFeed(nickname string, page *int) ([]*model.FeedPost, error)
-	GetTrending(nickname string, page *int) ([]*model.FeedPost, error)
+	GetFeed(nickname string, first *int, after *string) (*model.FeedPostConnection, error)
+	GetTrending(nickname string, tag *string, region *model.RegionFilter, first *int, after *string) (*model.FeedPostConnection, error)
+	GetRecommended(nickname string, first *int, after *string) (*model.FeedPostConnection, error)
+	MarkPostsSeen(sender string, postIDs []string) (bool, error)
+	GetPostsByHashtag(nickname, hashtag string, first *int, after *string) (*model.FeedPostConnection, error)
 }
 
 type feedRepository struct {
-	client *mongo.Database
+	client    *mongo.Database
+	timelines TimelineRepository
 }
 
-// PageSize constant
-const PageSize = 10
-
 type feedPost struct {
 	ID          string             `bson:"_id"`
 	Author      []*UserSchema      `bson:"author"`
@@ -30,128 +35,310 @@ type feedPost struct {
 	Timestamp   string             `bson:"timestamp"`
 	Comments    *model.CommentList `bson:"comments"`
 	LikeCount   int                `bson:"likecount"`
-	Likes       []string           `bson:"likes"`
-	BidID       *string            `bson:"bidID"`
+	Liked       bool               `bson:"liked"`
+	Hashtags    []string           `bson:"hashtags"`
+	Mentions    []string           `bson:"mentions"`
+	BidID       *string            `bson:"bidid"`
 }
 
-func (db *feedRepository) GetFeed(nickname string, page *int) ([]*model.FeedPost, error) {
-	if page == nil || *page < 1 {
-		*page = 1
+// GetFeed merges the owner's precomputed timeline with the recent posts of
+// followed accounts that are too big to fan out on write
+func (db *feedRepository) GetFeed(nickname string, first *int, after *string) (*model.FeedPostConnection, error) {
+	size, err := pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+	c, err := decodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	var afterID primitive.ObjectID
+	if c != nil {
+		afterID, err = primitive.ObjectIDFromHex(c.ID)
+		if err != nil {
+			return nil, errors.New("Invalid cursor")
+		}
 	}
 	collection := db.client.Collection(CollectionUsers)
 	result := collection.FindOne(context.TODO(), bson.M{"_id": nickname})
 	var u UserSchema
-	err := result.Decode(&u)
+	err = result.Decode(&u)
 	if err != nil {
 		return nil, errors.New("Unexpected Error")
 	}
-	collection = db.client.Collection(CollectionPosts)
-	ctx := context.TODO()
-	cursor, err := collection.Aggregate(ctx, mongo.Pipeline{
-		bson.D{{Key: "$match", Value: bson.M{"author": bson.M{"$in": u.Following}}}},
-		bson.D{{
-			Key: "$lookup",
-			Value: bson.M{
-				"from":         CollectionUsers,
-				"localField":   "author",
-				"foreignField": "_id",
-				"as":           "author",
-			}}},
-		bson.D{{Key: "$skip", Value: int64(PageSize * (*page - 1))}},
-		bson.D{{Key: "$limit", Value: PageSize}},
-		bson.D{{Key: "$sort", Value: bson.M{"timestamp": -1}}},
-	})
+	entries, err := db.timelines.GetEntries(nickname, c, size+1)
+	if err == nil && c == nil && len(entries) == 0 && len(u.Following) > 0 {
+		db.timelines.Rebuild(nickname, u.Following)
+		entries, err = db.timelines.GetEntries(nickname, c, size+1)
+	}
+	if err != nil {
+		return nil, errors.New("Could not load feed")
+	}
+	keys := make([]feedKey, 0, len(entries))
+	for _, e := range entries {
+		keys = append(keys, feedKey{e.Post, e.Timestamp})
+	}
+	authors, err := db.fanOutOnReadAuthors(u.Following)
 	if err != nil {
 		return nil, errors.New("Could not load feed")
 	}
-	var posts = make([]*model.FeedPost, 0)
+	if len(authors) > 0 {
+		match := bson.M{"author": bson.M{"$in": authors}}
+		if c != nil {
+			match = bson.M{"$and": []bson.M{match, keysetAfter("timestamp", c.Key, afterID)}}
+		}
+		opts := options.Find().
+			SetLimit(int64(size + 1)).
+			SetSort(bson.D{{Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}).
+			SetProjection(bson.M{"_id": 1, "timestamp": 1})
+		ctx := context.TODO()
+		cursor, err := db.client.Collection(CollectionPosts).Find(ctx, match, opts)
+		if err != nil {
+			return nil, errors.New("Could not load feed")
+		}
+		defer cursor.Close(ctx)
+		for cursor.Next(ctx) {
+			var k struct {
+				ID        primitive.ObjectID `bson:"_id"`
+				Timestamp string             `bson:"timestamp"`
+			}
+			if err := cursor.Decode(&k); err != nil {
+				return nil, err
+			}
+			keys = append(keys, feedKey{k.ID, k.Timestamp})
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].timestamp != keys[j].timestamp {
+			return keys[i].timestamp > keys[j].timestamp
+		}
+		return keys[i].id.Hex() > keys[j].id.Hex()
+	})
+	ids := make([]primitive.ObjectID, 0, size+1)
+	for i, k := range keys {
+		if len(ids) == size+1 {
+			break
+		}
+		if i > 0 && k.id == keys[i-1].id {
+			continue
+		}
+		ids = append(ids, k.id)
+	}
+	posts, err := db.findPosts(nickname, bson.M{"_id": bson.M{"$in": ids}}, bson.D{{Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}, size+1)
+	if err != nil {
+		return nil, err
+	}
+	return newFeedPostConnection(posts, size, c, func(p *feedPost) string {
+		return p.Timestamp
+	}), nil
+}
+
+type feedKey struct {
+	id        primitive.ObjectID
+	timestamp string
+}
+
+// fanOutOnReadAuthors returns the accounts in following whose posts are not
+// copied into timelines
+func (db *feedRepository) fanOutOnReadAuthors(following []string) ([]string, error) {
+	if len(following) == 0 {
+		return nil, nil
+	}
+	ctx := context.TODO()
+	cursor, err := db.client.Collection(CollectionUsers).Find(ctx, bson.M{
+		"_id":            bson.M{"$in": following},
+		"followerscount": bson.M{"$gt": FanOutThreshold},
+	}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
 	defer cursor.Close(ctx)
+	authors := make([]string, 0)
 	for cursor.Next(ctx) {
-		var p feedPost
-		err = cursor.Decode(&p)
-		liked := false
-		for _, l := range p.Likes {
-			if l == nickname {
-				liked = true
-				break
-			}
+		var u struct {
+			Nickname string `bson:"_id"`
 		}
-		posts = append(posts, &model.FeedPost{
-			ID: p.ID,
-			Author: &model.FeedUser{
-				Name:     p.Author[0].Name,
-				Nickname: p.Author[0].Nickname,
-				Picture:  p.Author[0].Picture,
-			},
-			Comments:    p.Comments,
-			Content:     p.Content,
-			Description: p.Description,
-			Likes:       p.LikeCount,
-			Liked:       liked,
-			Timestamp:   p.Timestamp,
-			BidID:       p.BidID,
-		})
+		if err := cursor.Decode(&u); err != nil {
+			return nil, err
+		}
+		authors = append(authors, u.Nickname)
 	}
-	return posts, err
+	return authors, nil
 }
 
-func (db *feedRepository) GetTrending(nickname string, page *int) ([]*model.FeedPost, error) {
-	if page == nil || *page < 1 {
-		*page = 1
+// GetTrending pages through the scores computed by TrendingJob, optionally
+// limited to authors with tag or located inside region
+func (db *feedRepository) GetTrending(nickname string, tag *string, region *model.RegionFilter, first *int, after *string) (*model.FeedPostConnection, error) {
+	size, err := pageSize(first)
+	if err != nil {
+		return nil, err
 	}
+	c, err := decodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	filters := make([]bson.M, 0)
+	if tag != nil && *tag != "" {
+		filters = append(filters, bson.M{"tags": *tag})
+	}
+	if region != nil {
+		within, err := withinRegion("location", region)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, within)
+	}
+	if c != nil {
+		id, err := primitive.ObjectIDFromHex(c.ID)
+		if err != nil {
+			return nil, errors.New("Invalid cursor")
+		}
+		score, err := strconv.ParseFloat(c.Key, 64)
+		if err != nil {
+			return nil, errors.New("Invalid cursor")
+		}
+		filters = append(filters, keysetAfter("score", score, id))
+	}
+	filter := bson.M{}
+	if len(filters) > 0 {
+		filter = bson.M{"$and": filters}
+	}
+	opts := options.Find().
+		SetLimit(int64(size + 1)).
+		SetSort(bson.D{{Key: "score", Value: -1}, {Key: "_id", Value: -1}})
+	ctx := context.TODO()
+	cursor, err := db.client.Collection(CollectionTrending).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, errors.New("Could not load feed")
+	}
+	defer cursor.Close(ctx)
+	entries := make([]*TrendingEntry, 0)
+	for cursor.Next(ctx) {
+		var entry TrendingEntry
+		if err := cursor.Decode(&entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &entry)
+	}
+	ids := make([]primitive.ObjectID, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.Post)
+	}
+	found, err := db.findPosts(nickname, bson.M{"_id": bson.M{"$in": ids}}, bson.D{{Key: "_id", Value: -1}}, len(ids)+1)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*feedPost)
+	for _, p := range found {
+		byID[p.ID] = p
+	}
+	posts := make([]*feedPost, 0, len(entries))
+	scores := make(map[string]string)
+	for _, entry := range entries {
+		if p, ok := byID[entry.Post.Hex()]; ok {
+			posts = append(posts, p)
+			scores[p.ID] = strconv.FormatFloat(entry.Score, 'g', -1, 64)
+		}
+	}
+	return newFeedPostConnection(posts, size, c, func(p *feedPost) string {
+		return scores[p.ID]
+	}), nil
+}
+
+// findPosts sorts and limits before looking up the authors so only the
+// returned page is joined. Whether viewer liked a post replaces its likes.
+func (db *feedRepository) findPosts(viewer string, match bson.M, sort bson.D, limit int) ([]*feedPost, error) {
 	collection := db.client.Collection(CollectionPosts)
 	ctx := context.TODO()
-	cursor, err := collection.Aggregate(ctx, mongo.Pipeline{
-		bson.D{{
-			Key: "$lookup",
-			Value: bson.M{
-				"from":         CollectionUsers,
-				"localField":   "author",
-				"foreignField": "_id",
-				"as":           "author",
-			}}},
-		bson.D{{Key: "$skip", Value: int64(PageSize * (*page - 1))}},
-		bson.D{{Key: "$limit", Value: PageSize}},
-		bson.D{{Key: "$sort", Value: bson.D{{Key: "likes", Value: -1}, {Key: "timestamp", Value: -1}}}},
-	})
+	pipeline := append(mongo.Pipeline{
+		bson.D{{Key: "$match", Value: match}},
+		bson.D{{Key: "$sort", Value: sort}},
+		bson.D{{Key: "$limit", Value: limit}},
+	}, likedBy(viewer)...)
+	pipeline = append(pipeline, bson.D{{
+		Key: "$lookup",
+		Value: bson.M{
+			"from":         CollectionUsers,
+			"localField":   "author",
+			"foreignField": "_id",
+			"as":           "author",
+		}}})
+	cursor, err := collection.Aggregate(ctx, pipeline)
 	if err != nil {
 		return nil, errors.New("Could not load feed")
 	}
-	var posts = make([]*model.FeedPost, 0)
+	var posts = make([]*feedPost, 0)
 	defer cursor.Close(ctx)
 	for cursor.Next(ctx) {
 		var p feedPost
 		err = cursor.Decode(&p)
-		liked := false
-		for _, l := range p.Likes {
-			if l == nickname {
-				liked = true
-				break
-			}
+		if err != nil {
+			return nil, err
+		}
+		if len(p.Author) == 0 {
+			continue
 		}
-		posts = append(posts, &model.FeedPost{
-			ID: p.ID,
-			Author: &model.FeedUser{
-				Name:     p.Author[0].Name,
-				Nickname: p.Author[0].Nickname,
-				Picture:  p.Author[0].Picture,
-			},
-			Comments:    p.Comments,
-			Content:     p.Content,
-			Description: p.Description,
-			Likes:       p.LikeCount,
-			Liked:       liked,
-			Timestamp:   p.Timestamp,
-			BidID:       p.BidID,
+		posts = append(posts, &p)
+	}
+	return posts, nil
+}
+
+func newFeedPostModel(p *feedPost) *model.FeedPost {
+	return &model.FeedPost{
+		ID: p.ID,
+		Author: &model.FeedUser{
+			Name:     p.Author[0].Name,
+			Nickname: p.Author[0].Nickname,
+			Picture:  p.Author[0].Picture,
+		},
+		Comments:    p.Comments,
+		Content:     p.Content,
+		Description: p.Description,
+		Likes:       p.LikeCount,
+		Liked:       p.Liked,
+		Hashtags:    p.Hashtags,
+		Mentions:    p.Mentions,
+		Timestamp:   p.Timestamp,
+		BidID:       p.BidID,
+	}
+}
+
+func newFeedPostConnection(posts []*feedPost, size int, after *pageCursor, key func(p *feedPost) string) *model.FeedPostConnection {
+	hasNextPage := len(posts) > size
+	if hasNextPage {
+		posts = posts[:size]
+	}
+	connection := &model.FeedPostConnection{
+		Edges: make([]*model.FeedPostEdge, 0),
+	}
+	for _, p := range posts {
+		connection.Edges = append(connection.Edges, &model.FeedPostEdge{
+			Cursor: encodeCursor(key(p), p.ID),
+			Node:   newFeedPostModel(p),
 		})
 	}
-	return posts, err
+	connection.PageInfo = newPageInfo(after, hasNextPage, len(connection.Edges), func(i int) string {
+		return connection.Edges[i].Cursor
+	})
+	return connection
 }
 
 // NewFeedRepository function
 func NewFeedRepository() FeedRepository {
 	client := newDatabaseClient()
+	client.Collection(CollectionPosts).Indexes().CreateOne(context.TODO(), mongo.IndexModel{
+		Keys: bson.D{{Key: "author", Value: 1}, {Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}},
+	})
+	client.Collection(CollectionSeenPosts).Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user", Value: 1}, {Key: "post", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.M{"expiresat": 1}, Options: options.Index().SetExpireAfterSeconds(0)},
+	})
+	timelines := NewTimelineRepository()
 	return &feedRepository{
 		client,
+		timelines,
 	}
 }
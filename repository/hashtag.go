@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MaxMentions caps how many users a single text can mention
+const MaxMentions = 20
+
+// hashtags and mentions must start the text or follow a character that
+// can't be part of them, so emails aren't read as mentions
+var (
+	hashtagPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_])#([\p{L}\p{N}_]+)`)
+	mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_])@([\p{L}\p{N}_.\-]+)`)
+)
+
+// HashtagEntry links a hashtag to a post that uses it in its description or
+// in one of its comments
+type HashtagEntry struct {
+	Hashtag   string             `bson:"hashtag"`
+	Post      primitive.ObjectID `bson:"post"`
+	Author    string             `bson:"author"`
+	Timestamp string             `bson:"timestamp"`
+}
+
+// ParseHashtags returns the distinct hashtags of text, lowercased and
+// without the leading #
+func ParseHashtags(text string) []string {
+	return parseTokens(hashtagPattern, text, "")
+}
+
+// ParseMentions returns the distinct nicknames mentioned in text, at most
+// MaxMentions of them
+func ParseMentions(text string) []string {
+	mentions := parseTokens(mentionPattern, text, ".-")
+	if len(mentions) > MaxMentions {
+		mentions = mentions[:MaxMentions]
+	}
+	return mentions
+}
+
+func parseTokens(pattern *regexp.Regexp, text, trim string) []string {
+	tokens := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, match := range pattern.FindAllStringSubmatch(text, -1) {
+		// punctuation ending a sentence isn't part of the token
+		token := strings.ToLower(strings.TrimRight(match[1], trim))
+		if token != "" && !seen[token] {
+			seen[token] = true
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+// existingUsers returns the nicknames that belong to a user, in order
+func existingUsers(client *mongo.Database, nicknames []string) ([]string, error) {
+	if len(nicknames) == 0 {
+		return make([]string, 0), nil
+	}
+	ctx := context.TODO()
+	cursor, err := client.Collection(CollectionUsers).Find(ctx, bson.M{"_id": bson.M{"$in": nicknames}},
+		options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	found := make(map[string]bool)
+	for cursor.Next(ctx) {
+		var u struct {
+			Nickname string `bson:"_id"`
+		}
+		if err := cursor.Decode(&u); err != nil {
+			return nil, err
+		}
+		found[u.Nickname] = true
+	}
+	users := make([]string, 0, len(found))
+	for _, nickname := range nicknames {
+		if found[nickname] {
+			users = append(users, nickname)
+		}
+	}
+	return users, nil
+}
+
+// parseMentions returns the users mentioned in text that exist
+func parseMentions(client *mongo.Database, text string) ([]string, error) {
+	return existingUsers(client, ParseMentions(text))
+}
+
+// missingFrom returns the elements of list that aren't in other
+func missingFrom(list, other []string) []string {
+	missing := make([]string, 0)
+	for _, s := range list {
+		if !stringInSlice(s, other) {
+			missing = append(missing, s)
+		}
+	}
+	return missing
+}
+
+// syncHashtags points the hashtag index at the hashtags currently used by
+// post and its comments
+func syncHashtags(client *mongo.Database, post *model.Post) error {
+	id, err := primitive.ObjectIDFromHex(post.ID)
+	if err != nil {
+		return err
+	}
+	hashtags := make([]string, 0)
+	seen := make(map[string]bool)
+	add := func(tags []string) {
+		for _, tag := range tags {
+			if !seen[tag] {
+				seen[tag] = true
+				hashtags = append(hashtags, tag)
+			}
+		}
+	}
+	add(post.Hashtags)
+	commentTags, err := client.Collection(CollectionComments).Distinct(context.TODO(), "hashtags", bson.M{"post": id})
+	if err != nil {
+		return err
+	}
+	for _, tag := range commentTags {
+		if tag, ok := tag.(string); ok {
+			add([]string{tag})
+		}
+	}
+	collection := client.Collection(CollectionHashtags)
+	_, err = collection.DeleteMany(context.TODO(), bson.M{"post": id, "hashtag": bson.M{"$nin": hashtags}})
+	if err != nil {
+		return err
+	}
+	entries := make([]interface{}, 0, len(hashtags))
+	for _, tag := range hashtags {
+		entries = append(entries, &HashtagEntry{
+			Hashtag:   tag,
+			Post:      id,
+			Author:    post.Author,
+			Timestamp: post.Timestamp,
+		})
+	}
+	return insertIgnoringDuplicates(collection, entries)
+}
+
+// GetPostsByHashtag pages through the posts using hashtag, newest first
+func (db *feedRepository) GetPostsByHashtag(nickname, hashtag string, first *int, after *string) (*model.FeedPostConnection, error) {
+	size, err := pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+	c, err := decodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	hashtag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(hashtag), "#"))
+	if hashtag == "" {
+		return nil, errors.New("Invalid hashtag")
+	}
+	var u UserSchema
+	err = db.client.Collection(CollectionUsers).FindOne(context.TODO(), bson.M{"_id": nickname}).Decode(&u)
+	if err != nil {
+		return nil, errors.New("User not found")
+	}
+	blocked, err := blockedUsers(db.client, &u)
+	if err != nil {
+		return nil, errors.New("Could not load posts")
+	}
+	excluded := make([]string, 0, len(blocked))
+	for b := range blocked {
+		excluded = append(excluded, b)
+	}
+	filter := bson.M{"hashtag": hashtag, "author": bson.M{"$nin": excluded}}
+	if c != nil {
+		id, err := primitive.ObjectIDFromHex(c.ID)
+		if err != nil {
+			return nil, errors.New("Invalid cursor")
+		}
+		filter["$or"] = []bson.M{
+			{"timestamp": bson.M{"$lt": c.Key}},
+			{"timestamp": c.Key, "post": bson.M{"$lt": id}},
+		}
+	}
+	opts := options.Find().
+		SetLimit(int64(size + 1)).
+		SetSort(bson.D{{Key: "timestamp", Value: -1}, {Key: "post", Value: -1}})
+	ctx := context.TODO()
+	cursor, err := db.client.Collection(CollectionHashtags).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, errors.New("Could not load posts")
+	}
+	defer cursor.Close(ctx)
+	ids := make([]primitive.ObjectID, 0)
+	for cursor.Next(ctx) {
+		var entry HashtagEntry
+		if err := cursor.Decode(&entry); err != nil {
+			return nil, err
+		}
+		ids = append(ids, entry.Post)
+	}
+	posts, err := db.findPosts(nickname, bson.M{"_id": bson.M{"$in": ids}},
+		bson.D{{Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}, len(ids)+1)
+	if err != nil {
+		return nil, err
+	}
+	return newFeedPostConnection(posts, size, c, func(p *feedPost) string {
+		return p.Timestamp
+	}), nil
+}
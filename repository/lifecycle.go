@@ -0,0 +1,307 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AuctionCloseInterval is how often auctions past their deadline are closed
+const AuctionCloseInterval = time.Minute
+
+// auctionTransitions lists the statuses each status can move to. COMPLETED
+// and CANCELLED are final. An awarded auction goes back to open or closed
+// when its host takes back the accepted bid.
+var auctionTransitions = map[model.AuctionStatus][]model.AuctionStatus{
+	model.AuctionStatusOpen:       {model.AuctionStatusClosed, model.AuctionStatusAwarded, model.AuctionStatusCancelled},
+	model.AuctionStatusClosed:     {model.AuctionStatusAwarded, model.AuctionStatusCancelled},
+	model.AuctionStatusAwarded:    {model.AuctionStatusOpen, model.AuctionStatusClosed, model.AuctionStatusInProgress, model.AuctionStatusCancelled},
+	model.AuctionStatusInProgress: {model.AuctionStatusDelivered, model.AuctionStatusDisputed},
+	model.AuctionStatusDelivered:  {model.AuctionStatusCompleted, model.AuctionStatusDisputed},
+	model.AuctionStatusDisputed:   {model.AuctionStatusCompleted, model.AuctionStatusCancelled},
+}
+
+// CanTransitionAuction reports whether an auction may go from one status to
+// another
+func CanTransitionAuction(from, to model.AuctionStatus) bool {
+	for _, status := range auctionTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// auctionSources returns the statuses that can move to status
+func auctionSources(status model.AuctionStatus) []model.AuctionStatus {
+	sources := make([]model.AuctionStatus, 0)
+	for _, from := range model.AllAuctionStatus {
+		if CanTransitionAuction(from, status) {
+			sources = append(sources, from)
+		}
+	}
+	return sources
+}
+
+func auctionStatusName(status model.AuctionStatus) string {
+	return strings.ReplaceAll(strings.ToLower(string(status)), "_", " ")
+}
+
+// transitionAuction moves an auction to status with a single conditional
+// update, so two conflicting transitions can't both succeed. filter narrows
+// down who may make the change and set is applied along with it. When
+// nothing matches, denied is returned unless the auction is missing or its
+// status doesn't allow the transition.
+func transitionAuction(client *mongo.Database, id primitive.ObjectID, status model.AuctionStatus, filter, set bson.M, denied error, opts ...*options.UpdateOptions) error {
+	condition := bson.M{"_id": id, "status": bson.M{"$in": auctionSources(status)}}
+	for k, v := range filter {
+		condition[k] = v
+	}
+	fields := bson.M{
+		"status":          status,
+		"statusupdatedat": strconv.FormatInt(time.Now().Unix(), 10),
+	}
+	for k, v := range set {
+		fields[k] = v
+	}
+	collection := client.Collection(CollectionAuctions)
+	result, err := collection.UpdateOne(context.TODO(), condition, bson.M{"$set": fields}, opts...)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount > 0 {
+		return nil
+	}
+	var auction model.Auction
+	err = collection.FindOne(context.TODO(), bson.M{"_id": id},
+		options.FindOne().SetProjection(bson.M{"status": 1})).Decode(&auction)
+	if err != nil {
+		return errors.New("Auction not found")
+	}
+	if !CanTransitionAuction(auction.Status, status) {
+		return errors.New("This auction is " + auctionStatusName(auction.Status) + " and can't be " + auctionStatusName(status))
+	}
+	return denied
+}
+
+// selectedBidder matches auctions where sender's bid was accepted
+func selectedBidder(sender string) bson.M {
+	return bson.M{"bids": bson.M{"$elemMatch": bson.M{"issuer": sender, "selected": true}}}
+}
+
+func (db *auctionRepository) transition(auctionID string, status model.AuctionStatus, filter bson.M) (bool, error) {
+	id, err := primitive.ObjectIDFromHex(auctionID)
+	if err != nil {
+		return false, errors.New("Invalid auctionID")
+	}
+	err = transitionAuction(db.client, id, status, filter, nil, errors.New("Unauthorized"))
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CloseAuction stops an auction from taking bids before its deadline
+func (db *auctionRepository) CloseAuction(sender, auctionID string) (bool, error) {
+	// an awarded auction is closed by unaccepting its bid instead
+	return db.transition(auctionID, model.AuctionStatusClosed, bson.M{"host": sender, "status": model.AuctionStatusOpen})
+}
+
+// CancelAuction calls an auction off, at the latest before it's paid.
+// Disputes are cancelled by a moderator instead.
+func (db *auctionRepository) CancelAuction(sender, auctionID string) (bool, error) {
+	return db.transition(auctionID, model.AuctionStatusCancelled, bson.M{
+		"host": sender,
+		"status": bson.M{"$in": []model.AuctionStatus{
+			model.AuctionStatusOpen,
+			model.AuctionStatusClosed,
+			model.AuctionStatusAwarded,
+		}},
+		"paymentstarted": bson.M{"$ne": true},
+	})
+}
+
+// MarkAuctionDelivered is how the artist whose bid was accepted hands in
+// their work
+func (db *auctionRepository) MarkAuctionDelivered(sender, auctionID string) (bool, error) {
+	return db.transition(auctionID, model.AuctionStatusDelivered, selectedBidder(sender))
+}
+
+// CompleteAuction is how the host accepts the delivered work. A disputed
+// auction is only closed by ResolveAuctionDispute.
+func (db *auctionRepository) CompleteAuction(sender, auctionID string) (bool, error) {
+	return db.transition(auctionID, model.AuctionStatusCompleted, bson.M{
+		"host":   sender,
+		"status": model.AuctionStatusDelivered,
+	})
+}
+
+// DisputeAuction lets either side of a paid auction ask a moderator to step in
+func (db *auctionRepository) DisputeAuction(sender, auctionID string) (bool, error) {
+	return db.transition(auctionID, model.AuctionStatusDisputed, bson.M{
+		"$or": []bson.M{{"host": sender}, selectedBidder(sender)},
+	})
+}
+
+// ResolveAuctionDispute closes a dispute as completed or cancelled
+func (db *auctionRepository) ResolveAuctionDispute(auctionID string, status model.AuctionStatus) (bool, error) {
+	if status != model.AuctionStatusCompleted && status != model.AuctionStatusCancelled {
+		return false, errors.New("Disputes can only be resolved as completed or cancelled")
+	}
+	return db.transition(auctionID, status, bson.M{"status": model.AuctionStatusDisputed})
+}
+
+// startAuctionWork moves an awarded auction in progress once the payment of
+// its accepted bid went through
+func startAuctionWork(client *mongo.Database, auctionID, bidID string) error {
+	id, err := primitive.ObjectIDFromHex(auctionID)
+	if err != nil {
+		return errors.New("Invalid auctionID")
+	}
+	return transitionAuction(client, id, model.AuctionStatusInProgress, bson.M{
+		"bids": bson.M{"$elemMatch": bson.M{"id": bidID, "selected": true}},
+	}, nil, errors.New("The paid bid wasn't accepted"))
+}
+
+// migrateBidStatus gives a status to the bids made before they had one, and
+// flags the auctions whose payment already started
+func migrateBidStatus(client *mongo.Database) error {
+	ctx := context.TODO()
+	auctions := client.Collection(CollectionAuctions)
+	_, err := auctions.UpdateMany(ctx, bson.M{
+		"bids": bson.M{"$elemMatch": bson.M{"status": bson.M{"$exists": false}}},
+	}, []bson.M{{"$set": bson.M{
+		"bids": bson.M{"$map": bson.M{
+			"input": "$bids",
+			"as":    "bid",
+			"in": bson.M{"$mergeObjects": bson.A{"$$bid", bson.M{
+				"status": bson.M{"$switch": bson.M{
+					"branches": bson.A{
+						bson.M{"case": "$$bid.selected", "then": model.BidStatusAccepted},
+						bson.M{"case": bson.M{"$in": bson.A{true, "$bids.selected"}}, "then": model.BidStatusRejected},
+					},
+					"default": model.BidStatusPending,
+				}},
+			}}},
+		}},
+	}}})
+	if err != nil {
+		return err
+	}
+	paid, err := client.Collection(CollectionPayments).Distinct(ctx, "auctionID", bson.M{})
+	if err != nil {
+		return err
+	}
+	ids := make([]primitive.ObjectID, 0, len(paid))
+	for _, auctionID := range paid {
+		if auctionID, ok := auctionID.(string); ok {
+			if id, err := primitive.ObjectIDFromHex(auctionID); err == nil {
+				ids = append(ids, id)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err = auctions.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}, "paymentstarted": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"paymentstarted": true}})
+	return err
+}
+
+// AuctionJob struct
+type AuctionJob struct {
+	client *mongo.Database
+	now    func() time.Time
+}
+
+// Start closes expired auctions now and then every AuctionCloseInterval
+func (j *AuctionJob) Start() {
+	go func() {
+		for {
+			j.Run()
+			time.Sleep(AuctionCloseInterval)
+		}
+	}()
+}
+
+// Run closes the open auctions whose deadline has passed
+func (j *AuctionJob) Run() error {
+	now := strconv.FormatInt(j.now().Unix(), 10)
+	_, err := j.client.Collection(CollectionAuctions).UpdateMany(context.TODO(), bson.M{
+		"status":   model.AuctionStatusOpen,
+		"deadline": bson.M{"$lte": now},
+	}, bson.M{
+		"$set": bson.M{"status": model.AuctionStatusClosed, "statusupdatedat": now},
+	})
+	return err
+}
+
+// migrateAuctionStatus derives the status of auctions created before they
+// had one from their bids, payments and deadline
+func migrateAuctionStatus(client *mongo.Database) error {
+	ctx := context.TODO()
+	auctions := client.Collection(CollectionAuctions)
+	cursor, err := auctions.Find(ctx, bson.M{"status": bson.M{"$exists": false}, "bids.selected": true},
+		options.Find().SetProjection(bson.M{"bids": 1}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var a model.Auction
+		if err := cursor.Decode(&a); err != nil {
+			return err
+		}
+		status := model.AuctionStatusAwarded
+		for _, b := range a.Bids {
+			if !b.Selected {
+				continue
+			}
+			paid, err := client.Collection(CollectionPayments).CountDocuments(ctx, bson.M{
+				"auctionID": a.ID,
+				"bidID":     b.ID,
+				"status":    "COMPLETED",
+			})
+			if err != nil {
+				return err
+			}
+			if paid > 0 {
+				status = model.AuctionStatusInProgress
+			}
+		}
+		id, err := primitive.ObjectIDFromHex(a.ID)
+		if err != nil {
+			continue
+		}
+		_, err = auctions.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"status": status}})
+		if err != nil {
+			return err
+		}
+	}
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	_, err = auctions.UpdateMany(ctx, bson.M{"status": bson.M{"$exists": false}, "deadline": bson.M{"$lte": now}},
+		bson.M{"$set": bson.M{"status": model.AuctionStatusClosed}})
+	if err != nil {
+		return err
+	}
+	_, err = auctions.UpdateMany(ctx, bson.M{"status": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"status": model.AuctionStatusOpen}})
+	return err
+}
+
+// NewAuctionJob function
+func NewAuctionJob() *AuctionJob {
+	client := newDatabaseClient()
+	return &AuctionJob{
+		client: client,
+		now:    time.Now,
+	}
+}
@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// likedBy are pipeline stages replacing the likes of each document with
+// whether viewer is among them, so like lists never leave the database
+func likedBy(viewer string) []bson.D {
+	return []bson.D{
+		{{Key: "$addFields", Value: bson.M{
+			"liked": bson.M{"$in": bson.A{viewer, bson.M{"$ifNull": bson.A{"$likes", bson.A{}}}}},
+		}}},
+		{{Key: "$project", Value: bson.M{"likes": 0}}},
+	}
+}
+
+// setLike adds or takes back sender's like on the document matched by
+// filter. The count only moves when the likes do, so repeating a request
+// changes nothing. It reports whether the document exists.
+func setLike(collection *mongo.Collection, filter bson.M, sender string, like bool) (bool, error) {
+	condition := bson.M{}
+	for k, v := range filter {
+		condition[k] = v
+	}
+	var update bson.M
+	if like {
+		condition["likes"] = bson.M{"$ne": sender}
+		update = bson.M{"$addToSet": bson.M{"likes": sender}, "$inc": bson.M{"likecount": 1}}
+	} else {
+		condition["likes"] = sender
+		update = bson.M{"$pull": bson.M{"likes": sender}, "$inc": bson.M{"likecount": -1}}
+	}
+	ctx := context.TODO()
+	result, err := collection.UpdateOne(ctx, condition, update)
+	if err != nil {
+		return false, err
+	}
+	if result.MatchedCount > 0 {
+		return true, nil
+	}
+	count, err := collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (db *postRepository) likePost(sender, postID string, like bool) (bool, error) {
+	id, err := primitive.ObjectIDFromHex(postID)
+	if err != nil {
+		return false, errors.New("Invalid postID")
+	}
+	found, err := setLike(db.client.Collection(CollectionPosts), bson.M{"_id": id}, sender, like)
+	if err != nil {
+		return false, err
+	}
+	if !found {
+		return false, errors.New("Post not found")
+	}
+	return true, nil
+}
+
+func (db *postRepository) LikePost(sender, postID string) (bool, error) {
+	return db.likePost(sender, postID, true)
+}
+
+func (db *postRepository) UnlikePost(sender, postID string) (bool, error) {
+	return db.likePost(sender, postID, false)
+}
+
+func (db *postRepository) likeComment(sender, postID, commentID string, like bool) (bool, error) {
+	id, err := primitive.ObjectIDFromHex(postID)
+	if err != nil {
+		return false, errors.New("Invalid postID")
+	}
+	commentObjectID, err := primitive.ObjectIDFromHex(commentID)
+	if err != nil {
+		return false, errors.New("Invalid commentID")
+	}
+	found, err := setLike(db.client.Collection(CollectionComments), bson.M{"_id": commentObjectID, "post": id}, sender, like)
+	if err != nil {
+		return false, err
+	}
+	if !found {
+		return false, errors.New("Comment not found")
+	}
+	return true, nil
+}
+
+func (db *postRepository) LikeComment(sender, postID, commentID string) (bool, error) {
+	return db.likeComment(sender, postID, commentID, true)
+}
+
+func (db *postRepository) UnlikeComment(sender, postID, commentID string) (bool, error) {
+	return db.likeComment(sender, postID, commentID, false)
+}
+
+// GetPostLikers pages through the users who liked a post by nickname
+func (db *postRepository) GetPostLikers(postID string, first *int, after *string) (*model.FeedUserConnection, error) {
+	size, err := pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+	c, err := decodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	id, err := primitive.ObjectIDFromHex(postID)
+	if err != nil {
+		return nil, errors.New("Invalid postID")
+	}
+	collection := db.client.Collection(CollectionPosts)
+	count, err := collection.CountDocuments(context.TODO(), bson.M{"_id": id})
+	if err != nil || count == 0 {
+		return nil, errors.New("Post not found")
+	}
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.M{"_id": id}}},
+		bson.D{{Key: "$project", Value: bson.M{"likes": 1}}},
+		bson.D{{Key: "$unwind", Value: "$likes"}},
+	}
+	if c != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"likes": bson.M{"$gt": c.ID}}}})
+	}
+	pipeline = append(pipeline,
+		bson.D{{Key: "$sort", Value: bson.M{"likes": 1}}},
+		bson.D{{Key: "$limit", Value: size + 1}},
+		bson.D{{
+			Key: "$lookup",
+			Value: bson.M{
+				"from":         CollectionUsers,
+				"localField":   "likes",
+				"foreignField": "_id",
+				"as":           "user",
+			}}},
+	)
+	ctx := context.TODO()
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, errors.New("Could not load likes")
+	}
+	defer cursor.Close(ctx)
+	connection := &model.FeedUserConnection{
+		Edges: make([]*model.FeedUserEdge, 0),
+	}
+	hasNextPage := false
+	for cursor.Next(ctx) {
+		if len(connection.Edges) == size {
+			hasNextPage = true
+			break
+		}
+		var like struct {
+			Nickname string        `bson:"likes"`
+			User     []*UserSchema `bson:"user"`
+		}
+		if err := cursor.Decode(&like); err != nil {
+			return nil, err
+		}
+		if len(like.User) == 0 {
+			continue
+		}
+		connection.Edges = append(connection.Edges, &model.FeedUserEdge{
+			Cursor: encodeCursor("", like.Nickname),
+			Node: &model.FeedUser{
+				Nickname: like.User[0].Nickname,
+				Name:     like.User[0].Name,
+				Picture:  like.User[0].Picture,
+			},
+		})
+	}
+	connection.PageInfo = newPageInfo(c, hasNextPage, len(connection.Edges), func(i int) string {
+		return connection.Edges[i].Cursor
+	})
+	return connection, nil
+}
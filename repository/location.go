@@ -0,0 +1,246 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ApproximateLocationPrecision is the grid, in degrees, approximate
+// locations are snapped to (about 11 km)
+const ApproximateLocationPrecision = 0.1
+
+// GetLocationPrivacy returns u's privacy setting, APPROXIMATE when unset
+func (u *UserSchema) GetLocationPrivacy() model.LocationPrivacy {
+	if u.LocationPrivacy.IsValid() {
+		return u.LocationPrivacy
+	}
+	return model.LocationPrivacyApproximate
+}
+
+// PublicLocation returns the coordinates of u that viewer may see. Owners
+// always see their exact location, everyone else the one stored by
+// publicLocationStage, so what they see is what they can search by.
+func (u *UserSchema) PublicLocation(viewer string) (lat, lng *float64) {
+	location := u.SharedLocation
+	if viewer == u.Nickname {
+		location = u.Location
+	}
+	if location == nil || len(location.Coordinates) != 2 {
+		return nil, nil
+	}
+	latitude, longitude := location.Coordinates[1], location.Coordinates[0]
+	return &latitude, &longitude
+}
+
+func validCoordinates(lat, lng float64) bool {
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
+}
+
+// withinRegion matches documents whose location field lies inside region
+func withinRegion(field string, region *model.RegionFilter) (bson.M, error) {
+	if region.RadiusKm <= 0 {
+		return nil, errors.New("Region radius must be positive")
+	}
+	if !validCoordinates(region.Lat, region.Lng) {
+		return nil, errors.New("Invalid coordinates")
+	}
+	return bson.M{field: bson.M{"$geoWithin": bson.M{
+		"$centerSphere": bson.A{bson.A{region.Lng, region.Lat}, region.RadiusKm / EarthRadiusKm},
+	}}}, nil
+}
+
+// locatable matches users that share their location with others
+func locatable() bson.M {
+	return bson.M{"publiclocation": bson.M{"$exists": true}}
+}
+
+// coarsenExpression snaps degrees to ApproximateLocationPrecision. Approximate
+// locations are only ever computed by it, since $round rounds half to even
+// unlike math.Round.
+func coarsenExpression(degrees interface{}) bson.M {
+	scale := 1 / ApproximateLocationPrecision
+	return bson.M{"$divide": bson.A{bson.M{"$round": bson.A{bson.M{"$multiply": bson.A{degrees, scale}}}}, scale}}
+}
+
+// publicLocationStage is an update pipeline stage storing the location
+// other users may see in publiclocation. Users are searched by it rather
+// than by their exact location, so small search radii can't narrow an
+// approximate location down.
+func publicLocationStage() bson.M {
+	approximate := bson.M{
+		"type": "Point",
+		"coordinates": bson.A{
+			coarsenExpression(bson.M{"$arrayElemAt": bson.A{"$location.coordinates", 0}}),
+			coarsenExpression(bson.M{"$arrayElemAt": bson.A{"$location.coordinates", 1}}),
+		},
+	}
+	return bson.M{"$set": bson.M{"publiclocation": bson.M{"$switch": bson.M{
+		"branches": bson.A{
+			bson.M{"case": bson.M{"$eq": bson.A{bson.M{"$type": "$location"}, "missing"}}, "then": "$$REMOVE"},
+			bson.M{"case": bson.M{"$eq": bson.A{"$locationprivacy", model.LocationPrivacyHidden}}, "then": "$$REMOVE"},
+			bson.M{"case": bson.M{"$eq": bson.A{"$locationprivacy", model.LocationPrivacyExact}}, "then": "$location"},
+		},
+		"default": approximate,
+	}}}}
+}
+
+func (db *userRepository) UpdateLocationPrivacy(sender string, privacy model.LocationPrivacy) (bool, error) {
+	if !privacy.IsValid() {
+		return false, errors.New("Invalid location privacy")
+	}
+	result, err := db.collection.UpdateOne(context.TODO(), bson.M{"_id": sender}, []bson.M{
+		{"$set": bson.M{"locationprivacy": privacy}},
+		publicLocationStage(),
+	})
+	if err != nil {
+		return false, err
+	}
+	if result.MatchedCount == 0 {
+		return false, errors.New("User not found")
+	}
+	return true, nil
+}
+
+// FindNear returns the users around a point, closest first, optionally only
+// those with one of tags. Users who hide their location are never returned.
+func (db *userRepository) FindNear(sender string, lat, lng, radiusKm float64, tags []string, first *int) ([]*model.NearbyUser, error) {
+	size, err := pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+	if radiusKm <= 0 {
+		return nil, errors.New("Radius must be positive")
+	}
+	if !validCoordinates(lat, lng) {
+		return nil, errors.New("Invalid coordinates")
+	}
+	query := locatable()
+	query["_id"] = bson.M{"$ne": sender}
+	if len(tags) > 0 {
+		users, err := db.usersWithTags(tags)
+		if err != nil {
+			return nil, errors.New("Could not load users")
+		}
+		query["_id"] = bson.M{"$ne": sender, "$in": users}
+	}
+	ctx := context.TODO()
+	cursor, err := db.collection.Aggregate(ctx, mongo.Pipeline{
+		bson.D{{Key: "$geoNear", Value: bson.M{
+			"near":          NewGeoPoint(lat, lng),
+			"key":           "publiclocation",
+			"distanceField": "distance",
+			"maxDistance":   radiusKm * 1000,
+			"spherical":     true,
+			"query":         query,
+		}}},
+		bson.D{{Key: "$limit", Value: size}},
+	})
+	if err != nil {
+		return nil, errors.New("Could not load users")
+	}
+	defer cursor.Close(ctx)
+	users := make([]*model.NearbyUser, 0)
+	for cursor.Next(ctx) {
+		var u UserSchema
+		if err := cursor.Decode(&u); err != nil {
+			return nil, err
+		}
+		user := newUserModel(&u, sender)
+		if user.Lat == nil {
+			continue
+		}
+		// the distance is measured to what the viewer can see, so approximate
+		// locations can't be narrowed down from it
+		users = append(users, &model.NearbyUser{
+			User:       user,
+			DistanceKm: math.Round(distanceKm(lat, lng, *user.Lat, *user.Lng)*10) / 10,
+		})
+	}
+	return users, nil
+}
+
+func (db *userRepository) usersWithTags(tags []string) ([]string, error) {
+	ctx := context.TODO()
+	cursor, err := db.client.Collection(CollectionTags).Find(ctx, bson.M{"_id": bson.M{"$in": tags}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	users := make([]string, 0)
+	for cursor.Next(ctx) {
+		var t TagSchema
+		if err := cursor.Decode(&t); err != nil {
+			return nil, err
+		}
+		users = append(users, t.Users...)
+	}
+	return users, nil
+}
+
+// usersInRegion returns the nicknames of the users inside region that share
+// their location
+func usersInRegion(client *mongo.Database, region *model.RegionFilter) ([]string, error) {
+	within, err := withinRegion("publiclocation", region)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"$and": []bson.M{locatable(), within}}
+	ctx := context.TODO()
+	cursor, err := client.Collection(CollectionUsers).Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	users := make([]string, 0)
+	for cursor.Next(ctx) {
+		var u struct {
+			Nickname string `bson:"_id"`
+		}
+		if err := cursor.Decode(&u); err != nil {
+			return nil, err
+		}
+		users = append(users, u.Nickname)
+	}
+	return users, nil
+}
+
+// migrateUserLocations moves the lat/lng fields of older user documents into
+// a GeoJSON location, and stores the public location of those without one
+func migrateUserLocations(collection *mongo.Collection) error {
+	ctx := context.TODO()
+	cursor, err := collection.Find(ctx, bson.M{
+		"$or": []bson.M{{"lat": bson.M{"$exists": true}}, {"lng": bson.M{"$exists": true}}},
+	}, options.Find().SetProjection(bson.M{"lat": 1, "lng": 1}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var legacy struct {
+			Nickname string  `bson:"_id"`
+			Lat      float64 `bson:"lat"`
+			Lng      float64 `bson:"lng"`
+		}
+		if err := cursor.Decode(&legacy); err != nil {
+			return err
+		}
+		update := bson.M{"$unset": bson.M{"lat": "", "lng": ""}}
+		if (legacy.Lat != 0 || legacy.Lng != 0) && validCoordinates(legacy.Lat, legacy.Lng) {
+			update["$set"] = bson.M{"location": NewGeoPoint(legacy.Lat, legacy.Lng)}
+		}
+		if _, err := collection.UpdateOne(ctx, bson.M{"_id": legacy.Nickname}, update); err != nil {
+			return err
+		}
+	}
+	_, err = collection.UpdateMany(ctx, bson.M{
+		"location":       bson.M{"$exists": true},
+		"publiclocation": bson.M{"$exists": false},
+	}, []bson.M{publicLocationStage()})
+	return err
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestPublicLocation(t *testing.T) {
+	u := &UserSchema{
+		Nickname:       "user",
+		Location:       NewGeoPoint(48.8566, 2.3522),
+		SharedLocation: NewGeoPoint(48.9, 2.4),
+	}
+	if lat, lng := u.PublicLocation("user"); lat == nil || *lat != 48.8566 || *lng != 2.3522 {
+		t.Errorf("owner sees %v, %v", lat, lng)
+	}
+	if lat, lng := u.PublicLocation("other"); lat == nil || *lat != 48.9 || *lng != 2.4 {
+		t.Errorf("others see %v, %v", lat, lng)
+	}
+	u.SharedLocation = nil
+	if lat, _ := u.PublicLocation("other"); lat != nil {
+		t.Error("hidden location is visible")
+	}
+}
+
+// TestPublicLocationRounding checks that the location users see is the one
+// they are searched by, even where rounding half up and half to even differ
+func TestPublicLocationRounding(t *testing.T) {
+	db := newTestUserRepository(t)
+	_, err := db.collection.Indexes().CreateOne(context.TODO(), mongo.IndexModel{Keys: bson.M{"publiclocation": "2dsphere"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.collection.InsertOne(context.TODO(), &UserSchema{Nickname: "user"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, privacy := range []model.LocationPrivacy{model.LocationPrivacyApproximate, model.LocationPrivacyExact} {
+		if _, err := db.UpdateLocationPrivacy("user", privacy); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := db.UpdateLocation("user", 0.25, -0.35); err != nil {
+			t.Fatal(err)
+		}
+		var u UserSchema
+		if err := db.collection.FindOne(context.TODO(), bson.M{"_id": "user"}).Decode(&u); err != nil {
+			t.Fatal(err)
+		}
+		lat, lng := u.PublicLocation("other")
+		if lat == nil || *lat != u.SharedLocation.Coordinates[1] || *lng != u.SharedLocation.Coordinates[0] {
+			t.Errorf("%s: others see %v, %v, searched at %v", privacy, lat, lng, u.SharedLocation)
+		}
+		users, err := db.FindNear("other", *lat, *lng, 0.01, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(users) != 1 || users[0].DistanceKm != 0 {
+			t.Errorf("%s: users at the location they are shown at = %v", privacy, users)
+		}
+	}
+}
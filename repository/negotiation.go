@@ -0,0 +1,332 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Limits of the negotiation of a bid
+const (
+	MaxBidRevisions     = 50
+	MaxBidMessages      = 100
+	MaxBidMessageLength = 1000
+)
+
+// bids can be negotiated until the auction is awarded
+var negotiableStatuses = []model.AuctionStatus{model.AuctionStatusOpen, model.AuctionStatusClosed}
+
+// the threads of bids are closed along with their auction
+var finalStatuses = []model.AuctionStatus{model.AuctionStatusCompleted, model.AuctionStatusCancelled}
+
+// visibleBids hides the negotiation of each bid from everyone but the host
+// and the bidder
+func visibleBids(bids []*model.Bid, host, viewer string) []*model.Bid {
+	visible := make([]*model.Bid, 0, len(bids))
+	for _, b := range bids {
+		if viewer != host && viewer != b.Issuer {
+			hidden := *b
+			hidden.CounterOffer = nil
+			hidden.History = make([]*model.BidRevision, 0)
+			hidden.Messages = make([]*model.BidMessage, 0)
+			b = &hidden
+		}
+		visible = append(visible, b)
+	}
+	return visible
+}
+
+func newBidRevision(revision int, t model.BidRevisionType, author string, price float64, deadline string, message *string) *model.BidRevision {
+	return &model.BidRevision{
+		Revision:  revision,
+		Type:      t,
+		Author:    author,
+		Price:     price,
+		Deadline:  deadline,
+		Message:   message,
+		Timestamp: strconv.FormatInt(time.Now().Unix(), 10),
+	}
+}
+
+func validateBidMessage(message *string) (*string, error) {
+	if message == nil {
+		return nil, nil
+	}
+	text := strings.TrimSpace(*message)
+	if text == "" {
+		return nil, nil
+	}
+	if len(text) > MaxBidMessageLength {
+		return nil, errors.New("Messages can't be longer than " + strconv.Itoa(MaxBidMessageLength) + " characters")
+	}
+	return &text, nil
+}
+
+// bidTerms returns the price and deadline of a new revision of bid, keeping
+// those that don't change
+func bidTerms(bid *model.Bid, price *float64, deadline *string) (float64, string, error) {
+	if price == nil && deadline == nil {
+		return 0, "", errors.New("A revision needs a new price or deadline")
+	}
+	p, d := bid.Price, bid.Deadline
+	if price != nil {
+		if *price <= 0 {
+			return 0, "", errors.New("Invalid price")
+		}
+		p = *price
+	}
+	if deadline != nil {
+		if _, err := parseUnixTime(*deadline, "deadline"); err != nil {
+			return 0, "", err
+		}
+		d = *deadline
+	}
+	return p, d, nil
+}
+
+// findBid loads the auction of a bid that can still be negotiated
+func (db *auctionRepository) findBid(auctionID, bidID string) (primitive.ObjectID, *model.Auction, *model.Bid, error) {
+	id, err := primitive.ObjectIDFromHex(auctionID)
+	if err != nil {
+		return id, nil, nil, errors.New("Invalid auctionID")
+	}
+	var auction model.Auction
+	err = db.client.Collection(CollectionAuctions).FindOne(context.TODO(), bson.M{"_id": id}).Decode(&auction)
+	if err != nil {
+		return id, nil, nil, errors.New("Auction not found")
+	}
+	for _, b := range auction.Bids {
+		if b.ID == bidID {
+			return id, &auction, b, nil
+		}
+	}
+	return id, nil, nil, errors.New("Bid not found")
+}
+
+func requireNegotiable(auction *model.Auction, bid *model.Bid) error {
+	if auction.Status != model.AuctionStatusOpen && auction.Status != model.AuctionStatusClosed {
+		return errors.New("Bids can't be negotiated after the auction was awarded")
+	}
+	if bid.Status != model.BidStatusPending {
+		return errors.New("Only pending bids can be negotiated")
+	}
+	if len(bid.History) >= MaxBidRevisions {
+		return errors.New("This bid can't be revised anymore")
+	}
+	return nil
+}
+
+// requireMessageable fails once the thread of a bid is closed, either with
+// its auction or because another bid was accepted
+func requireMessageable(auction *model.Auction, bid *model.Bid) error {
+	for _, status := range finalStatuses {
+		if auction.Status == status {
+			return errors.New("Messages can't be sent after the auction was " + auctionStatusName(status))
+		}
+	}
+	if bid.Status == model.BidStatusRejected {
+		return errors.New("Messages can't be sent on a rejected bid")
+	}
+	return nil
+}
+
+// updateBid applies update to the bid when it matches match, through the
+// positional operator, and returns the bid as it is afterwards
+func (db *auctionRepository) updateBid(id primitive.ObjectID, bidID string, status interface{}, match, update bson.M, conflict error) (*model.Bid, error) {
+	match["id"] = bidID
+	filter := bson.M{"_id": id, "bids": bson.M{"$elemMatch": match}}
+	if status != nil {
+		filter["status"] = status
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var auction model.Auction
+	err := db.client.Collection(CollectionAuctions).FindOneAndUpdate(context.TODO(), filter, update, opts).Decode(&auction)
+	if err != nil {
+		return nil, conflict
+	}
+	for _, b := range auction.Bids {
+		if b.ID == bidID {
+			return b, nil
+		}
+	}
+	return nil, conflict
+}
+
+// negotiate moves a bid to a new agreed revision. The update only applies
+// while the bid is still at the revision it was read at, so concurrent
+// changes can't overwrite each other, and acceptBid can tell which terms
+// the host saw.
+func (db *auctionRepository) negotiate(id primitive.ObjectID, bid *model.Bid, match bson.M, entry *model.BidRevision) (*model.Bid, error) {
+	match["revision"] = bid.Revision
+	match["status"] = model.BidStatusPending
+	return db.updateBid(id, bid.ID, bson.M{"$in": negotiableStatuses}, match, bson.M{
+		"$set": bson.M{
+			"bids.$.price":        entry.Price,
+			"bids.$.deadline":     entry.Deadline,
+			"bids.$.revision":     entry.Revision,
+			"bids.$.counteroffer": nil,
+		},
+		"$push": bson.M{"bids.$.history": entry},
+	}, errors.New("The bid changed in the meantime, please try again"))
+}
+
+// ReviseBid lets the bidder change the price or deadline of their bid. It
+// replaces any counter-offer of the host.
+func (db *auctionRepository) ReviseBid(sender, auctionID, bidID string, price *float64, deadline, message *string) (*model.Bid, error) {
+	id, auction, bid, err := db.findBid(auctionID, bidID)
+	if err != nil {
+		return nil, err
+	}
+	if bid.Issuer != sender {
+		return nil, errors.New("Unauthorized")
+	}
+	if err := requireNegotiable(auction, bid); err != nil {
+		return nil, err
+	}
+	p, d, err := bidTerms(bid, price, deadline)
+	if err != nil {
+		return nil, err
+	}
+	message, err = validateBidMessage(message)
+	if err != nil {
+		return nil, err
+	}
+	entry := newBidRevision(bid.Revision+1, model.BidRevisionTypeRevision, sender, p, d, message)
+	return db.negotiate(id, bid, bson.M{"issuer": sender}, entry)
+}
+
+// CounterOfferBid lets the host propose other terms for a bid. They only
+// apply once the bidder accepts them.
+func (db *auctionRepository) CounterOfferBid(sender, auctionID, bidID string, price *float64, deadline, message *string) (*model.Bid, error) {
+	id, auction, bid, err := db.findBid(auctionID, bidID)
+	if err != nil {
+		return nil, err
+	}
+	if auction.Host != sender {
+		return nil, errors.New("Unauthorized")
+	}
+	if err := requireNegotiable(auction, bid); err != nil {
+		return nil, err
+	}
+	p, d, err := bidTerms(bid, price, deadline)
+	if err != nil {
+		return nil, err
+	}
+	message, err = validateBidMessage(message)
+	if err != nil {
+		return nil, err
+	}
+	entry := newBidRevision(bid.Revision, model.BidRevisionTypeCounterOffer, sender, p, d, message)
+	return db.updateBid(id, bidID, bson.M{"$in": negotiableStatuses}, bson.M{
+		"revision": bid.Revision,
+		"status":   model.BidStatusPending,
+	}, bson.M{
+		"$set":  bson.M{"bids.$.counteroffer": entry},
+		"$push": bson.M{"bids.$.history": entry},
+	}, errors.New("The bid changed in the meantime, please try again"))
+}
+
+// AcceptCounterOffer makes the terms of the host's counter-offer the latest
+// revision of the bid
+func (db *auctionRepository) AcceptCounterOffer(sender, auctionID, bidID string) (*model.Bid, error) {
+	id, auction, bid, err := db.findBid(auctionID, bidID)
+	if err != nil {
+		return nil, err
+	}
+	if bid.Issuer != sender {
+		return nil, errors.New("Unauthorized")
+	}
+	if err := requireNegotiable(auction, bid); err != nil {
+		return nil, err
+	}
+	counter := bid.CounterOffer
+	if counter == nil {
+		return nil, errors.New("There is no counter-offer to accept")
+	}
+	entry := newBidRevision(bid.Revision+1, model.BidRevisionTypeCounterAccepted, sender, counter.Price, counter.Deadline, nil)
+	// the host may have replaced the counter-offer since it was read
+	return db.negotiate(id, bid, bson.M{
+		"issuer":                sender,
+		"counteroffer.price":    counter.Price,
+		"counteroffer.deadline": counter.Deadline,
+	}, entry)
+}
+
+// SendBidMessage adds a message to the thread between the host and the
+// bidder, which keeps the latest MaxBidMessages
+func (db *auctionRepository) SendBidMessage(sender, auctionID, bidID, message string) (*model.BidMessage, error) {
+	id, auction, bid, err := db.findBid(auctionID, bidID)
+	if err != nil {
+		return nil, err
+	}
+	if auction.Host != sender && bid.Issuer != sender {
+		return nil, errors.New("Unauthorized")
+	}
+	if err := requireMessageable(auction, bid); err != nil {
+		return nil, err
+	}
+	text, err := validateBidMessage(&message)
+	if err != nil {
+		return nil, err
+	}
+	if text == nil {
+		return nil, errors.New("Message can't be empty")
+	}
+	m := &model.BidMessage{
+		Author:    sender,
+		Message:   *text,
+		Timestamp: strconv.FormatInt(time.Now().Unix(), 10),
+	}
+	_, err = db.updateBid(id, bidID, bson.M{"$nin": finalStatuses}, bson.M{
+		"status": bson.M{"$ne": model.BidStatusRejected},
+	}, bson.M{
+		"$push": bson.M{"bids.$.messages": bson.M{
+			"$each":  []*model.BidMessage{m},
+			"$slice": -MaxBidMessages,
+		}},
+	}, errors.New("Could not send message"))
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// migrateBidHistory starts the history of the bids made before they could
+// be negotiated with their original terms
+func migrateBidHistory(client *mongo.Database) error {
+	_, err := client.Collection(CollectionAuctions).UpdateMany(context.TODO(), bson.M{
+		"bids": bson.M{"$elemMatch": bson.M{"revision": bson.M{"$exists": false}}},
+	}, []bson.M{{"$set": bson.M{
+		"bids": bson.M{"$map": bson.M{
+			"input": "$bids",
+			"as":    "bid",
+			"in": bson.M{"$cond": bson.A{
+				bson.M{"$eq": bson.A{bson.M{"$type": "$$bid.revision"}, "missing"}},
+				bson.M{"$mergeObjects": bson.A{"$$bid", bson.M{
+					"revision":     1,
+					"counteroffer": nil,
+					"history": bson.A{bson.M{
+						"revision":  1,
+						"type":      model.BidRevisionTypeBid,
+						"author":    "$$bid.issuer",
+						"price":     "$$bid.price",
+						"deadline":  "$$bid.deadline",
+						"message":   nil,
+						"timestamp": "$$bid.timestamp",
+					}},
+					"messages": bson.A{},
+				}}},
+				"$$bid",
+			}},
+		}},
+	}}})
+	return err
+}
@@ -0,0 +1,254 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// NotificationRepository interface
+type NotificationRepository interface {
+	NotifyMentions(actor string, mentioned []string, postID primitive.ObjectID, commentID *string) error
+	RemoveMentions(mentioned []string, postID primitive.ObjectID, commentID *string) error
+	RemovePost(postID primitive.ObjectID) error
+	RemoveComments(commentIDs []string) error
+	GetNotifications(sender string, unreadOnly bool, first *int, after *string) (*model.NotificationConnection, error)
+	UnreadCount(sender string) (int, error)
+	MarkRead(sender string, notificationIDs []string) (bool, error)
+}
+
+type notificationRepository struct {
+	client     *mongo.Database
+	collection *mongo.Collection
+}
+
+// NotificationSchema struct
+type NotificationSchema struct {
+	ID        primitive.ObjectID     `bson:"_id,omitempty"`
+	User      string                 `bson:"user"`
+	Type      model.NotificationType `bson:"type"`
+	Actor     string                 `bson:"actor"`
+	Post      primitive.ObjectID     `bson:"post"`
+	Comment   *string                `bson:"comment,omitempty"`
+	Read      bool                   `bson:"read"`
+	Timestamp string                 `bson:"timestamp"`
+}
+
+// mentionFilter matches the mention notifications of a post's description,
+// or of one of its comments
+func mentionFilter(postID primitive.ObjectID, commentID *string) bson.M {
+	filter := bson.M{"type": model.NotificationTypeMention, "post": postID}
+	if commentID != nil {
+		filter["comment"] = *commentID
+	} else {
+		filter["comment"] = bson.M{"$exists": false}
+	}
+	return filter
+}
+
+// NotifyMentions tells the mentioned users about a mention by actor, except
+// actor and those who blocked them
+func (db *notificationRepository) NotifyMentions(actor string, mentioned []string, postID primitive.ObjectID, commentID *string) error {
+	if len(mentioned) == 0 {
+		return nil
+	}
+	ctx := context.TODO()
+	cursor, err := db.client.Collection(CollectionUsers).Find(ctx, bson.M{
+		"_id":     bson.M{"$in": mentioned, "$ne": actor},
+		"blocked": bson.M{"$ne": actor},
+	}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	notifications := make([]interface{}, 0)
+	for cursor.Next(ctx) {
+		var u struct {
+			Nickname string `bson:"_id"`
+		}
+		if err := cursor.Decode(&u); err != nil {
+			return err
+		}
+		notifications = append(notifications, &NotificationSchema{
+			User:      u.Nickname,
+			Type:      model.NotificationTypeMention,
+			Actor:     actor,
+			Post:      postID,
+			Comment:   commentID,
+			Read:      false,
+			Timestamp: timestamp,
+		})
+	}
+	if len(notifications) == 0 {
+		return nil
+	}
+	_, err = db.collection.InsertMany(ctx, notifications)
+	return err
+}
+
+// RemoveMentions withdraws the notifications of users no longer mentioned
+func (db *notificationRepository) RemoveMentions(mentioned []string, postID primitive.ObjectID, commentID *string) error {
+	if len(mentioned) == 0 {
+		return nil
+	}
+	filter := mentionFilter(postID, commentID)
+	filter["user"] = bson.M{"$in": mentioned}
+	_, err := db.collection.DeleteMany(context.TODO(), filter)
+	return err
+}
+
+func (db *notificationRepository) RemovePost(postID primitive.ObjectID) error {
+	_, err := db.collection.DeleteMany(context.TODO(), bson.M{"post": postID})
+	return err
+}
+
+func (db *notificationRepository) RemoveComments(commentIDs []string) error {
+	if len(commentIDs) == 0 {
+		return nil
+	}
+	_, err := db.collection.DeleteMany(context.TODO(), bson.M{"comment": bson.M{"$in": commentIDs}})
+	return err
+}
+
+func (db *notificationRepository) GetNotifications(sender string, unreadOnly bool, first *int, after *string) (*model.NotificationConnection, error) {
+	size, err := pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+	c, err := decodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"user": sender}
+	if unreadOnly {
+		filter["read"] = false
+	}
+	if c != nil {
+		id, err := primitive.ObjectIDFromHex(c.ID)
+		if err != nil {
+			return nil, errors.New("Invalid cursor")
+		}
+		filter = bson.M{"$and": []bson.M{filter, keysetAfter("timestamp", c.Key, id)}}
+	}
+	opts := options.Find().
+		SetLimit(int64(size + 1)).
+		SetSort(bson.D{{Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}})
+	ctx := context.TODO()
+	cursor, err := db.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, errors.New("Could not load notifications")
+	}
+	defer cursor.Close(ctx)
+	notifications := make([]*NotificationSchema, 0)
+	actors := make([]string, 0)
+	for cursor.Next(ctx) {
+		var n NotificationSchema
+		if err := cursor.Decode(&n); err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, &n)
+		actors = append(actors, n.Actor)
+	}
+	hasNextPage := len(notifications) > size
+	if hasNextPage {
+		notifications = notifications[:size]
+	}
+	users := make(map[string]*UserSchema)
+	if len(actors) > 0 {
+		cursor, err := db.client.Collection(CollectionUsers).Find(ctx, bson.M{"_id": bson.M{"$in": actors}},
+			options.Find().SetProjection(bson.M{"name": 1, "picture": 1}))
+		if err != nil {
+			return nil, errors.New("Could not load notifications")
+		}
+		defer cursor.Close(ctx)
+		for cursor.Next(ctx) {
+			var u UserSchema
+			if err := cursor.Decode(&u); err != nil {
+				return nil, err
+			}
+			users[u.Nickname] = &u
+		}
+	}
+	connection := &model.NotificationConnection{
+		Edges: make([]*model.NotificationEdge, 0),
+	}
+	for _, n := range notifications {
+		actor, ok := users[n.Actor]
+		if !ok {
+			continue
+		}
+		connection.Edges = append(connection.Edges, &model.NotificationEdge{
+			Cursor: encodeCursor(n.Timestamp, n.ID.Hex()),
+			Node: &model.Notification{
+				ID:   n.ID.Hex(),
+				Type: n.Type,
+				Actor: &model.FeedUser{
+					Nickname: actor.Nickname,
+					Name:     actor.Name,
+					Picture:  actor.Picture,
+				},
+				PostID:    n.Post.Hex(),
+				CommentID: n.Comment,
+				Read:      n.Read,
+				Timestamp: n.Timestamp,
+			},
+		})
+	}
+	connection.PageInfo = newPageInfo(c, hasNextPage, len(connection.Edges), func(i int) string {
+		return connection.Edges[i].Cursor
+	})
+	return connection, nil
+}
+
+func (db *notificationRepository) UnreadCount(sender string) (int, error) {
+	count, err := db.collection.CountDocuments(context.TODO(), bson.M{"user": sender, "read": false})
+	if err != nil {
+		return 0, errors.New("Could not load notifications")
+	}
+	return int(count), nil
+}
+
+// MarkRead marks the given notifications of sender as read, or all of them
+// when no IDs are given
+func (db *notificationRepository) MarkRead(sender string, notificationIDs []string) (bool, error) {
+	filter := bson.M{"user": sender, "read": false}
+	if notificationIDs != nil {
+		ids := make([]primitive.ObjectID, 0, len(notificationIDs))
+		for _, notificationID := range notificationIDs {
+			id, err := primitive.ObjectIDFromHex(notificationID)
+			if err != nil {
+				return false, errors.New("Invalid notificationID")
+			}
+			ids = append(ids, id)
+		}
+		filter["_id"] = bson.M{"$in": ids}
+	}
+	_, err := db.collection.UpdateMany(context.TODO(), filter, bson.M{"$set": bson.M{"read": true}})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// NewNotificationRepository function
+func NewNotificationRepository() NotificationRepository {
+	client := newDatabaseClient()
+	collection := client.Collection(CollectionNotifications)
+	collection.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "user", Value: 1}, {Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "user", Value: 1}, {Key: "read", Value: 1}}},
+		{Keys: bson.D{{Key: "post", Value: 1}, {Key: "comment", Value: 1}}},
+	})
+	return &notificationRepository{
+		client,
+		collection,
+	}
+}
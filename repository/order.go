@@ -144,6 +144,16 @@ func (db *orderRepository) UpdateOrder(orderID, status string, payerID *string)
 	if err != nil || result.ModifiedCount == 0 {
 		return false, errors.New("Order not found")
 	}
+	if status == "COMPLETED" {
+		var p Payment
+		err = collection.FindOne(context.TODO(), bson.M{"paymentID": orderID}).Decode(&p)
+		if err != nil {
+			return false, errors.New("Order not found")
+		}
+		if err := startAuctionWork(db.client, p.AuctionID, p.BidID); err != nil {
+			return false, err
+		}
+	}
 	return true, nil
 }
 
@@ -0,0 +1,241 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"github.com/eaemenkkstudios/cancanvas-backend/service"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Mail job statuses
+const (
+	MailPending = "pending"
+	MailSending = "sending"
+	MailSent    = "sent"
+	MailDead    = "dead"
+)
+
+// Mail queue settings
+const (
+	// MailMaxAttempts is how many deliveries are tried before a mail is dead-lettered
+	MailMaxAttempts = 8
+	// MailBaseBackoff is the wait after the first failure, doubling with every retry
+	MailBaseBackoff = 30 * time.Second
+	// MailMaxBackoff caps the wait between retries
+	MailMaxBackoff = time.Hour
+	// MailLease is how long a worker owns a claimed job before others may retry it
+	MailLease = 2 * time.Minute
+	// MailPollInterval is how often the worker looks for due jobs
+	MailPollInterval = 5 * time.Second
+	// MailSentTTL is how long delivered mail is kept around
+	MailSentTTL = 7 * 24 * time.Hour
+)
+
+// MailJob struct
+type MailJob struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	Mail          service.Mail       `bson:"mail"`
+	Status        string             `bson:"status"`
+	Attempts      int                `bson:"attempts"`
+	LastError     string             `bson:"lasterror"`
+	CreatedAt     time.Time          `bson:"createdat"`
+	NextAttemptAt time.Time          `bson:"nextattemptat"`
+	LockedUntil   time.Time          `bson:"lockeduntil"`
+	ExpiresAt     *time.Time         `bson:"expiresat,omitempty"`
+}
+
+// MailOutbox is a MailerService whose Send only enqueues the mail, leaving
+// delivery to MailWorker
+type MailOutbox interface {
+	service.MailerService
+	Stats() (*model.MailQueueStats, error)
+}
+
+type mailOutbox struct {
+	collection *mongo.Collection
+}
+
+func (o *mailOutbox) Send(mail *service.Mail) error {
+	if _, _, _, err := service.RenderMail(mail); err != nil {
+		return err
+	}
+	now := time.Now()
+	_, err := o.collection.InsertOne(context.TODO(), &MailJob{
+		Mail:          *mail,
+		Status:        MailPending,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	})
+	return err
+}
+
+func (o *mailOutbox) Stats() (*model.MailQueueStats, error) {
+	cursor, err := o.collection.Aggregate(context.TODO(), []bson.M{
+		{"$group": bson.M{"_id": "$status", "count": bson.M{"$sum": 1}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var counts []struct {
+		Status string `bson:"_id"`
+		Count  int    `bson:"count"`
+	}
+	if err := cursor.All(context.TODO(), &counts); err != nil {
+		return nil, err
+	}
+	stats := &model.MailQueueStats{}
+	for _, c := range counts {
+		switch c.Status {
+		case MailPending:
+			stats.Pending = c.Count
+		case MailSending:
+			stats.Sending = c.Count
+		case MailSent:
+			stats.Sent = c.Count
+		case MailDead:
+			stats.Dead = c.Count
+		}
+	}
+	result := o.collection.FindOne(context.TODO(), bson.M{"status": MailPending},
+		options.FindOne().SetSort(bson.M{"createdat": 1}))
+	var oldest MailJob
+	if err := result.Decode(&oldest); err == nil {
+		timestamp := strconv.FormatInt(oldest.CreatedAt.Unix(), 10)
+		stats.OldestPending = &timestamp
+	}
+	return stats, nil
+}
+
+func mailOutboxCollection(client *mongo.Database) *mongo.Collection {
+	collection := client.Collection(CollectionMailOutbox)
+	collection.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "nextattemptat", Value: 1}}},
+		{Keys: bson.M{"expiresat": 1}, Options: options.Index().SetExpireAfterSeconds(0)},
+	})
+	return collection
+}
+
+// NewMailOutbox function
+func NewMailOutbox() MailOutbox {
+	client := newDatabaseClient()
+	return &mailOutbox{
+		mailOutboxCollection(client),
+	}
+}
+
+// MailWorker struct
+type MailWorker struct {
+	collection *mongo.Collection
+	mailer     service.MailerService
+	now        func() time.Time
+}
+
+// Start delivers queued mail in the background until the process exits
+func (w *MailWorker) Start() {
+	go func() {
+		for {
+			w.drain()
+			time.Sleep(MailPollInterval)
+		}
+	}()
+}
+
+func (w *MailWorker) drain() {
+	for {
+		job, err := w.claim()
+		if err != nil || job == nil {
+			return
+		}
+		w.deliver(job)
+	}
+}
+
+// purgeMailData clears the data of jobs that finished before it was
+// cleared on delivery
+func purgeMailData(collection *mongo.Collection) error {
+	_, err := collection.UpdateMany(context.TODO(), bson.M{
+		"status":    bson.M{"$in": []string{MailSent, MailDead}},
+		"mail.data": bson.M{"$exists": true},
+	}, bson.M{"$unset": bson.M{"mail.data": ""}})
+	return err
+}
+
+// claim takes the next due job, including jobs whose worker died mid-delivery
+func (w *MailWorker) claim() (*MailJob, error) {
+	now := w.now()
+	result := w.collection.FindOneAndUpdate(context.TODO(), bson.M{
+		"$or": []bson.M{
+			{"status": MailPending, "nextattemptat": bson.M{"$lte": now}},
+			{"status": MailSending, "lockeduntil": bson.M{"$lte": now}},
+		},
+	}, bson.M{
+		"$set": bson.M{"status": MailSending, "lockeduntil": now.Add(MailLease)},
+		"$inc": bson.M{"attempts": 1},
+	}, options.FindOneAndUpdate().
+		SetSort(bson.M{"nextattemptat": 1}).
+		SetReturnDocument(options.After))
+	var job MailJob
+	err := result.Decode(&job)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (w *MailWorker) deliver(job *MailJob) {
+	err := w.mailer.Send(&job.Mail)
+	now := w.now()
+	update := bson.M{}
+	switch {
+	case err == nil:
+		update["$set"] = bson.M{"status": MailSent, "lasterror": "", "expiresat": now.Add(MailSentTTL)}
+		// the data may hold single-use links, which mustn't outlive delivery
+		update["$unset"] = bson.M{"mail.data": ""}
+	case service.IsPermanentMailError(err) || job.Attempts >= MailMaxAttempts:
+		update["$set"] = bson.M{"status": MailDead, "lasterror": err.Error()}
+		update["$unset"] = bson.M{"mail.data": ""}
+	default:
+		update["$set"] = bson.M{
+			"status":        MailPending,
+			"lasterror":     err.Error(),
+			"nextattemptat": now.Add(mailBackoff(job.Attempts)),
+		}
+	}
+	w.collection.UpdateOne(context.TODO(), bson.M{"_id": job.ID, "status": MailSending}, update)
+}
+
+func mailBackoff(attempts int) time.Duration {
+	backoff := MailBaseBackoff
+	for i := 1; i < attempts && backoff < MailMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > MailMaxBackoff {
+		backoff = MailMaxBackoff
+	}
+	return backoff
+}
+
+// NewMailWorker function
+func NewMailWorker() (*MailWorker, error) {
+	mailer, err := service.NewMailerService()
+	if err != nil {
+		return nil, err
+	}
+	client := newDatabaseClient()
+	collection := mailOutboxCollection(client)
+	purgeMailData(collection)
+	return &MailWorker{
+		collection: collection,
+		mailer:     mailer,
+		now:        time.Now,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Page sizes
+const (
+	// DefaultPageSize is used when a query doesn't ask for a page size
+	DefaultPageSize = 10
+	// MaxPageSize caps the page size a client may ask for
+	MaxPageSize = 50
+)
+
+// pageCursor is the decoded form of a cursor. Key is the value of the field
+// the list is sorted by and ID breaks ties between equal keys.
+type pageCursor struct {
+	Key string `json:"k,omitempty"`
+	ID  string `json:"id"`
+}
+
+func encodeCursor(key, id string) string {
+	b, _ := json.Marshal(&pageCursor{Key: key, ID: id})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeCursor returns nil when no cursor was given
+func decodeCursor(cursor *string) (*pageCursor, error) {
+	if cursor == nil || *cursor == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(*cursor)
+	if err != nil {
+		return nil, errors.New("Invalid cursor")
+	}
+	var c pageCursor
+	if err := json.Unmarshal(b, &c); err != nil || c.ID == "" {
+		return nil, errors.New("Invalid cursor")
+	}
+	return &c, nil
+}
+
+func pageSize(first *int) (int, error) {
+	if first == nil {
+		return DefaultPageSize, nil
+	}
+	if *first < 1 {
+		return 0, errors.New("first must be a positive number")
+	}
+	if *first > MaxPageSize {
+		return MaxPageSize, nil
+	}
+	return *first, nil
+}
+
+// keysetAfter matches the documents that come after (key, id) in a list
+// sorted by field and then _id, both descending
+func keysetAfter(field string, key, id interface{}) bson.M {
+	return bson.M{"$or": []bson.M{
+		{field: bson.M{"$lt": key}},
+		{field: key, "_id": bson.M{"$lt": id}},
+	}}
+}
+
+// keysetAfterAscending is keysetAfter for lists sorted in ascending order
+func keysetAfterAscending(field string, key, id interface{}) bson.M {
+	return bson.M{"$or": []bson.M{
+		{field: bson.M{"$gt": key}},
+		{field: key, "_id": bson.M{"$gt": id}},
+	}}
+}
+
+// newPageInfo describes a page of count edges, reading their cursors through
+// cursorAt
+func newPageInfo(after *pageCursor, hasNextPage bool, count int, cursorAt func(i int) string) *model.PageInfo {
+	info := &model.PageInfo{
+		HasNextPage:     hasNextPage,
+		HasPreviousPage: after != nil,
+	}
+	if count > 0 {
+		startCursor, endCursor := cursorAt(0), cursorAt(count-1)
+		info.StartCursor = &startCursor
+		info.EndCursor = &endCursor
+	}
+	return info
+}
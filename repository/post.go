@@ -18,99 +18,82 @@ import (
 
 // PostRepository interface
 type PostRepository interface {
-	GetPosts(sender, author string, page *int) ([]*model.Post, error)
-	GetComments(sender, postID string, page *int) ([]*model.PostComment, error)
+	GetPosts(sender, author string, first *int, after *string) (*model.PostConnection, error)
+	GetComments(sender, postID string, parentID *string, first *int, after *string) (*model.PostCommentConnection, error)
 	CreatePost(author string, content graphql.Upload, description, bidID *string) (string, error)
 	EditPost(author, postID, description string) (bool, error)
 	DeletePost(author, postID string) (bool, error)
+	RemovePost(postID string) (bool, error)
 	LikePost(sender, postID string) (bool, error)
-	CommentOnPost(sender, postID, message string) (string, error)
+	UnlikePost(sender, postID string) (bool, error)
+	GetPostLikers(postID string, first *int, after *string) (*model.FeedUserConnection, error)
+	CommentOnPost(sender, postID, message string, parentID *string) (string, error)
 	EditComment(sender, postID, commentID, message string) (bool, error)
 	DeleteComment(sender, postID, commentID string) (bool, error)
+	RemoveComment(postID, commentID string) (bool, error)
 	LikeComment(sender, postID, commentID string) (bool, error)
+	UnlikeComment(sender, postID, commentID string) (bool, error)
 }
 
 type postRepository struct {
-	client     *mongo.Database
-	awsSession service.AwsService
+	client        *mongo.Database
+	awsSession    service.AwsService
+	timelines     TimelineRepository
+	notifications NotificationRepository
 }
 
-func (db *postRepository) GetPosts(sender, author string, page *int) ([]*model.Post, error) {
-	if page == nil || *page < 1 {
-		*page = 1
+func (db *postRepository) GetPosts(sender, author string, first *int, after *string) (*model.PostConnection, error) {
+	size, err := pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+	c, err := decodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"author": author}
+	if c != nil {
+		id, err := primitive.ObjectIDFromHex(c.ID)
+		if err != nil {
+			return nil, errors.New("Invalid cursor")
+		}
+		filter = bson.M{"$and": []bson.M{filter, keysetAfter("timestamp", c.Key, id)}}
 	}
 	collection := db.client.Collection(CollectionPosts)
-	opts := options.Find().
-		SetSkip(int64(PageSize * (*page - 1))).
-		SetLimit(PageSize).
-		SetSort(bson.M{"timestamp": -1})
+	pipeline := append(mongo.Pipeline{
+		bson.D{{Key: "$match", Value: filter}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}}},
+		bson.D{{Key: "$limit", Value: size + 1}},
+	}, likedBy(sender)...)
 	ctx := context.TODO()
-	cursor, err := collection.Find(ctx, bson.M{"author": author}, opts)
+	cursor, err := collection.Aggregate(ctx, pipeline)
 	if err != nil {
 		return nil, errors.New("Could not load posts")
 	}
-	var posts = make([]*model.Post, 0)
+	connection := &model.PostConnection{
+		Edges: make([]*model.PostEdge, 0),
+	}
+	hasNextPage := false
 	defer cursor.Close(ctx)
 	for cursor.Next(ctx) {
+		if len(connection.Edges) == size {
+			hasNextPage = true
+			break
+		}
 		var p model.Post
 		err = cursor.Decode(&p)
-		p.Liked = false
-		for _, l := range p.Likes {
-			if l == sender {
-				p.Liked = true
-				break
-			}
-		}
-		posts = append(posts, &p)
-	}
-	return posts, err
-}
-
-func (db *postRepository) GetComments(sender, postID string, page *int) ([]*model.PostComment, error) {
-	if page == nil || *page < 1 {
-		*page = 1
-	}
-	collection := db.client.Collection(CollectionPosts)
-	id, err := primitive.ObjectIDFromHex(postID)
-	if err != nil {
-		return nil, errors.New("Invalid postID")
-	}
-	result := collection.FindOne(context.TODO(), bson.M{"_id": id})
-	var p model.Post
-	err = result.Decode(&p)
-	if err != nil {
-		return nil, errors.New("Post not found")
-	}
-	commentList := make([]*model.PostComment, 0)
-	for i := PageSize * (*page - 1); i < PageSize**page && i < len(p.Comments.List); i++ {
-		collection = db.client.Collection(CollectionUsers)
-		result = collection.FindOne(context.TODO(), bson.M{"_id": p.Comments.List[i].Author})
-		var u UserSchema
-		err = result.Decode(&u)
 		if err != nil {
 			return nil, err
 		}
-		liked := false
-		for _, l := range p.Comments.List[i].Likes {
-			if l == sender {
-				liked = true
-				break
-			}
-		}
-		commentList = append(commentList, &model.PostComment{
-			ID: p.Comments.List[i].ID,
-			Author: &model.FeedUser{
-				Name:     u.Name,
-				Nickname: u.Nickname,
-				Picture:  u.Picture,
-			},
-			Likes:     p.Comments.List[i].LikeCount,
-			Liked:     liked,
-			Text:      p.Comments.List[i].Text,
-			Timestamp: p.Comments.List[i].Timestamp,
+		connection.Edges = append(connection.Edges, &model.PostEdge{
+			Cursor: encodeCursor(p.Timestamp, p.ID),
+			Node:   &p,
 		})
 	}
-	return commentList, nil
+	connection.PageInfo = newPageInfo(c, hasNextPage, len(connection.Edges), func(i int) string {
+		return connection.Edges[i].Cursor
+	})
+	return connection, nil
 }
 
 func (db *postRepository) CreatePost(author string, content graphql.Upload, description, bidID *string) (string, error) {
@@ -125,8 +108,17 @@ func (db *postRepository) CreatePost(author string, content graphql.Upload, desc
 	if err != nil {
 		return "", errors.New("Could not upload file")
 	}
-	collection = db.client.Collection(CollectionPosts)
-	result, err := collection.InsertOne(context.TODO(), &model.Post{
+	hashtags := make([]string, 0)
+	mentions := make([]string, 0)
+	if description != nil {
+		hashtags = ParseHashtags(*description)
+		mentions, err = parseMentions(db.client, *description)
+		if err != nil {
+			return "", errors.New("Could not create post")
+		}
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	post := &model.Post{
 		Description: description,
 		Author:      author,
 		Comments: &model.CommentList{
@@ -135,14 +127,22 @@ func (db *postRepository) CreatePost(author string, content graphql.Upload, desc
 		},
 		Content:   filepath,
 		LikeCount: 0,
-		Likes:     make([]string, 0),
-		Timestamp: strconv.FormatInt(time.Now().Unix(), 10),
+		Hashtags:  hashtags,
+		Mentions:  mentions,
+		Timestamp: timestamp,
 		BidID:     bidID,
-	})
+	}
+	collection = db.client.Collection(CollectionPosts)
+	result, err := collection.InsertOne(context.TODO(), post)
 	if err != nil {
 		return "", errors.New("Could not create post")
 	}
-	return result.InsertedID.(primitive.ObjectID).Hex(), nil
+	postID := result.InsertedID.(primitive.ObjectID)
+	post.ID = postID.Hex()
+	db.timelines.FanOut(&u, postID, timestamp)
+	syncHashtags(db.client, post)
+	db.notifications.NotifyMentions(author, mentions, postID, nil)
+	return post.ID, nil
 }
 
 func (db *postRepository) EditPost(author, postID, description string) (bool, error) {
@@ -154,248 +154,74 @@ func (db *postRepository) EditPost(author, postID, description string) (bool, er
 	if err != nil {
 		return false, errors.New("Invalid postID")
 	}
-	result, err := collection.UpdateOne(context.TODO(), bson.M{"_id": id, "author": author}, bson.M{
-		"$set": bson.M{"description": description},
-	})
-	if err != nil || result.ModifiedCount == 0 {
-		return false, errors.New("Could not edit post")
-	}
-	return true, nil
-}
-
-func (db *postRepository) DeletePost(author, postID string) (bool, error) {
-	collection := db.client.Collection(CollectionUsers)
-	user := collection.FindOne(context.TODO(), bson.M{"_id": author})
-	var u UserSchema
-	err := user.Decode(&u)
+	mentions, err := parseMentions(db.client, description)
 	if err != nil {
-		return false, errors.New("Unexpected error")
+		return false, errors.New("Could not edit post")
 	}
-	collection = db.client.Collection(CollectionPosts)
-	id, err := primitive.ObjectIDFromHex(postID)
-	result := collection.FindOne(context.TODO(), bson.M{"_id": id, "author": author})
+	hashtags := ParseHashtags(description)
+	// the post as it was before the edit, to tell which mentions are new
 	var post model.Post
-	err = result.Decode(&post)
-	if err != nil {
-		return false, errors.New("Post not found")
-	}
-	urlPrefix := db.awsSession.GetURLPrefix()
-	status, err := db.awsSession.DeleteFile(strings.TrimPrefix(post.Content, urlPrefix))
+	err = collection.FindOneAndUpdate(context.TODO(), bson.M{"_id": id, "author": author}, bson.M{
+		"$set": bson.M{"description": description, "hashtags": hashtags, "mentions": mentions},
+	}).Decode(&post)
 	if err != nil {
-		return status, err
-	}
-	_, err = collection.DeleteOne(context.TODO(), bson.M{"_id": id})
-	if err != nil {
-		return false, err
+		return false, errors.New("Could not edit post")
 	}
+	db.notifications.RemoveMentions(missingFrom(post.Mentions, mentions), id, nil)
+	db.notifications.NotifyMentions(author, missingFrom(mentions, post.Mentions), id, nil)
+	post.Description = &description
+	post.Hashtags = hashtags
+	post.Mentions = mentions
+	syncHashtags(db.client, &post)
 	return true, nil
 }
 
-func (db *postRepository) LikePost(sender, postID string) (bool, error) {
+func (db *postRepository) DeletePost(author, postID string) (bool, error) {
 	collection := db.client.Collection(CollectionUsers)
-	result := collection.FindOne(context.TODO(), bson.M{"_id": sender})
+	user := collection.FindOne(context.TODO(), bson.M{"_id": author})
 	var u UserSchema
-	err := result.Decode(&u)
+	err := user.Decode(&u)
 	if err != nil {
 		return false, errors.New("Unexpected error")
 	}
-	collection = db.client.Collection(CollectionPosts)
 	id, err := primitive.ObjectIDFromHex(postID)
 	if err != nil {
 		return false, errors.New("Invalid postID")
 	}
-	result = collection.FindOne(context.TODO(), bson.M{"_id": id})
-	var post model.Post
-	err = result.Decode(&post)
-	if err != nil {
-		return false, errors.New("Post not found")
-	}
-	likeIndex := -1
-	for i, l := range post.Likes {
-		if l == sender {
-			likeIndex = i
-			break
-		}
-	}
-	if likeIndex == -1 {
-		post.Likes = append(post.Likes, sender)
-		post.LikeCount++
-	} else {
-		post.Likes = append(post.Likes[:likeIndex], post.Likes[likeIndex+1:]...)
-		post.LikeCount--
-	}
-	_, err = collection.UpdateOne(context.TODO(), bson.M{"_id": id}, bson.M{
-		"$set": bson.M{"likes": post.Likes, "likecount": post.LikeCount},
-	})
-	if err != nil {
-		return false, err
-	}
-	return true, nil
+	return db.deletePost(bson.M{"_id": id, "author": author})
 }
 
-func (db *postRepository) CommentOnPost(sender, postID, message string) (string, error) {
-	collection := db.client.Collection(CollectionUsers)
-	result := collection.FindOne(context.TODO(), bson.M{"_id": sender})
-	var u UserSchema
-	err := result.Decode(&u)
-	if err != nil {
-		return "", errors.New("Unexpected error")
-	}
-	collection = db.client.Collection(CollectionPosts)
-	id, err := primitive.ObjectIDFromHex(postID)
-	if err != nil {
-		return "", errors.New("Invalid postID")
-	}
-	result = collection.FindOne(context.TODO(), bson.M{"_id": id})
-	var post model.Post
-	err = result.Decode(&post)
-	if err != nil {
-		return "", errors.New("Post not found")
-	}
-	commentID := primitive.NewObjectID().Hex()
-	post.Comments.List = append(post.Comments.List, &model.Comment{
-		ID:        commentID,
-		Author:    sender,
-		Text:      message,
-		LikeCount: 0,
-		Likes:     make([]string, 0),
-		Timestamp: strconv.FormatInt(time.Now().Unix(), 10),
-	})
-	post.Comments.Count++
-	_, err = collection.UpdateOne(context.TODO(), bson.M{"_id": id}, bson.M{
-		"$set": bson.M{"comments": post.Comments},
-	})
-	if err != nil {
-		return "", err
-	}
-	return commentID, nil
-}
-
-func (db *postRepository) EditComment(sender, postID, commentID, message string) (bool, error) {
-	collection := db.client.Collection(CollectionPosts)
+func (db *postRepository) RemovePost(postID string) (bool, error) {
 	id, err := primitive.ObjectIDFromHex(postID)
 	if err != nil {
 		return false, errors.New("Invalid postID")
 	}
-	result := collection.FindOne(context.TODO(), bson.M{"_id": id})
-	var p model.Post
-	err = result.Decode(&p)
-	if err != nil {
-		return false, errors.New("Post not found")
-	}
-	edited := false
-	for i, c := range p.Comments.List {
-		if c.ID == commentID && c.Author == sender {
-			p.Comments.List[i].Text = message
-			p.Comments.List[i].Timestamp = strconv.FormatInt(time.Now().Unix(), 10)
-			edited = true
-			break
-		}
-	}
-	if !edited {
-		return false, errors.New("Comment not found")
-	}
-	res, err := collection.UpdateOne(context.TODO(), bson.M{"_id": id}, bson.M{
-		"$set": bson.M{"comments": p.Comments},
-	})
-	if err != nil && res.ModifiedCount > 0 {
-		return true, nil
-	}
-	return false, err
+	return db.deletePost(bson.M{"_id": id})
 }
 
-func (db *postRepository) DeleteComment(sender, postID, commentID string) (bool, error) {
-	collection := db.client.Collection(CollectionUsers)
-	user := collection.FindOne(context.TODO(), bson.M{"_id": sender})
-	var u UserSchema
-	err := user.Decode(&u)
-	if err != nil {
-		return false, errors.New("Unexpected error")
-	}
-	collection = db.client.Collection(CollectionPosts)
-	id, err := primitive.ObjectIDFromHex(postID)
-	if err != nil {
-		return false, errors.New("Invalid postID")
-	}
-	result := collection.FindOne(context.TODO(), bson.M{"_id": id})
+func (db *postRepository) deletePost(filter bson.M) (bool, error) {
+	collection := db.client.Collection(CollectionPosts)
+	result := collection.FindOne(context.TODO(), filter)
 	var post model.Post
-	err = result.Decode(&post)
+	err := result.Decode(&post)
 	if err != nil {
 		return false, errors.New("Post not found")
 	}
-	for i, c := range post.Comments.List {
-		if c.ID == commentID {
-			if c.Author == sender || post.Author == sender {
-				post.Comments.List = append(post.Comments.List[:i], post.Comments.List[i+1:]...)
-				post.Comments.Count--
-			} else {
-				return false, errors.New("Unauthorized")
-			}
-		}
-	}
-	_, err = collection.UpdateOne(context.TODO(), bson.M{"_id": id}, bson.M{
-		"$set": bson.M{"comments": post.Comments},
-	})
-	if err != nil {
-		return false, err
-	}
-	return true, nil
-}
-
-func (db *postRepository) LikeComment(sender, postID, commentID string) (bool, error) {
-	collection := db.client.Collection(CollectionUsers)
-	result := collection.FindOne(context.TODO(), bson.M{"_id": sender})
-	var u UserSchema
-	err := result.Decode(&u)
-	if err != nil {
-		return false, errors.New("Unexpected error")
-	}
-	collection = db.client.Collection(CollectionPosts)
-	id, err := primitive.ObjectIDFromHex(postID)
-	if err != nil {
-		return false, errors.New("Invalid postID")
-	}
-	result = collection.FindOne(context.TODO(), bson.M{"_id": id})
-	var post model.Post
-	err = result.Decode(&post)
+	urlPrefix := db.awsSession.GetURLPrefix()
+	status, err := db.awsSession.DeleteFile(strings.TrimPrefix(post.Content, urlPrefix))
 	if err != nil {
-		return false, errors.New("Post not found")
-	}
-	commentIndex := -1
-	likeIndex := -1
-	for i, c := range post.Comments.List {
-		if c.ID == commentID {
-			commentIndex = i
-			for index, l := range post.Comments.List[i].Likes {
-				if l == sender {
-					likeIndex = index
-					break
-				}
-			}
-		}
-	}
-	if commentIndex == -1 {
-		return false, errors.New("Comment not found")
-	}
-	if likeIndex == -1 {
-		post.Comments.List[commentIndex].Likes = append(
-			post.Comments.List[commentIndex].Likes,
-			sender,
-		)
-		post.Comments.List[commentIndex].LikeCount++
-	} else {
-		post.Comments.List[commentIndex].Likes = append(
-			post.Comments.List[commentIndex].Likes[:likeIndex],
-			post.Comments.List[commentIndex].Likes[likeIndex+1:]...,
-		)
-		post.Comments.List[commentIndex].LikeCount--
+		return status, err
 	}
-	_, err = collection.UpdateOne(context.TODO(), bson.M{"_id": id}, bson.M{
-		"$set": bson.M{"comments.list": post.Comments.List},
-	})
+	_, err = collection.DeleteOne(context.TODO(), filter)
 	if err != nil {
 		return false, err
 	}
+	if id, err := primitive.ObjectIDFromHex(post.ID); err == nil {
+		db.timelines.RemovePost(id)
+		db.client.Collection(CollectionHashtags).DeleteMany(context.TODO(), bson.M{"post": id})
+		db.client.Collection(CollectionComments).DeleteMany(context.TODO(), bson.M{"post": id})
+		db.notifications.RemovePost(id)
+	}
 	return true, nil
 }
 
@@ -403,8 +229,26 @@ func (db *postRepository) LikeComment(sender, postID, commentID string) (bool, e
 func NewPostRepository() PostRepository {
 	client := newDatabaseClient()
 	awsSession := service.NewAwsService()
+	timelines := NewTimelineRepository()
+	notifications := NewNotificationRepository()
+	client.Collection(CollectionHashtags).Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "hashtag", Value: 1}, {Key: "post", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "hashtag", Value: 1}, {Key: "timestamp", Value: -1}, {Key: "post", Value: -1}}},
+		{Keys: bson.M{"post": 1}},
+	})
+	client.Collection(CollectionComments).Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "post", Value: 1}, {Key: "parent", Value: 1}, {Key: "timestamp", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.M{"ancestors": 1}},
+		{Keys: bson.D{{Key: "author", Value: 1}, {Key: "timestamp", Value: -1}}},
+	})
+	migrateComments(client)
 	return &postRepository{
 		client,
 		awsSession,
+		timelines,
+		notifications,
 	}
 }
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"github.com/eaemenkkstudios/cancanvas-backend/service"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// identityIndex keeps an external identity from being linked to more than one
+// account, even when two requests link it at the same time
+func identityIndex(client *mongo.Database) error {
+	_, err := client.Collection(CollectionUsers).Indexes().CreateOne(context.TODO(), mongo.IndexModel{
+		Keys: bson.D{{Key: "identities.provider", Value: 1}, {Key: "identities.subject", Value: 1}},
+		Options: options.Index().SetUnique(true).
+			SetPartialFilterExpression(bson.M{"identities.subject": bson.M{"$exists": true}}),
+	})
+	return err
+}
+
+// isLinked reports whether an identity belongs to an account
+func (db *authRespository) isLinked(identity *service.OIDCIdentity) bool {
+	count, err := db.client.Collection(CollectionUsers).CountDocuments(context.TODO(), identityFilter(identity.Provider, identity.Subject))
+	return err != nil || count > 0
+}
+
+func identityFilter(provider, subject string) bson.M {
+	return bson.M{"identities": bson.M{"$elemMatch": bson.M{
+		"provider": provider,
+		"subject":  subject,
+	}}}
+}
+
+func (db *authRespository) LoginWithProvider(provider, code, nonce string) (*model.Login, error) {
+	identity, err := db.oidc.Exchange(provider, code, nonce)
+	if err != nil {
+		return nil, err
+	}
+	collection := db.client.Collection(CollectionUsers)
+	result := collection.FindOne(context.TODO(), identityFilter(identity.Provider, identity.Subject))
+	var user UserSchema
+	err = result.Decode(&user)
+	if err == nil {
+		return db.startLogin(&user)
+	}
+	token := service.NewJWTService().GenerateProviderSignupToken(identity)
+	return &model.Login{
+		NicknameRequired: true,
+		Challenge:        &token,
+	}, nil
+}
+
+func (db *authRespository) CompleteProviderSignup(token, nickname string) (*model.Login, error) {
+	identity, err := service.NewJWTService().GetProviderSignupIdentity(token)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(nickname) == "" {
+		return nil, errors.New("Invalid nickname")
+	}
+	if db.isLinked(identity) {
+		return nil, errors.New("This account is already linked")
+	}
+	name := identity.Name
+	if name == "" {
+		name = nickname
+	}
+	user := &UserSchema{
+		Email:          identity.Email,
+		EmailVerified:  identity.Email != "" && identity.EmailVerified,
+		Nickname:       strings.ToLower(nickname),
+		Name:           name,
+		Following:      make([]string, 0),
+		FollowersCount: 0,
+		Followers:      make([]string, 0),
+		Chats:          make([]userChat, 0),
+		First:          true,
+		Role:           model.RoleUser,
+		Picture:        identity.Picture,
+		Cover:          "",
+		Bio:            "",
+		Identities: []Identity{{
+			Provider: identity.Provider,
+			Subject:  identity.Subject,
+		}},
+	}
+	_, err = db.client.Collection(CollectionUsers).InsertOne(context.TODO(), user)
+	if err != nil {
+		if db.isLinked(identity) {
+			return nil, errors.New("This account is already linked")
+		}
+		return nil, errors.New("User '" + nickname + "' already exists")
+	}
+	return db.completeLogin(user)
+}
+
+func (db *authRespository) LinkProvider(sender, provider, code, nonce string) (bool, error) {
+	identity, err := db.oidc.Exchange(provider, code, nonce)
+	if err != nil {
+		return false, err
+	}
+	if db.isLinked(identity) {
+		return false, errors.New("This account is already linked")
+	}
+	_, err = db.client.Collection(CollectionUsers).UpdateOne(context.TODO(), bson.M{"_id": sender}, bson.M{
+		"$addToSet": bson.M{"identities": Identity{
+			Provider: identity.Provider,
+			Subject:  identity.Subject,
+		}},
+	})
+	if err != nil {
+		if db.isLinked(identity) {
+			return false, errors.New("This account is already linked")
+		}
+		return false, err
+	}
+	return true, nil
+}
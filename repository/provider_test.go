@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/service"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// stubOIDCService accepts the codes it knows with testNonce
+type stubOIDCService map[string]*service.OIDCIdentity
+
+const testNonce = "nonce"
+
+func (s stubOIDCService) Exchange(provider, code, nonce string) (*service.OIDCIdentity, error) {
+	identity, ok := s[code]
+	if !ok || nonce != testNonce || identity.Provider != provider {
+		return nil, errors.New("Invalid authorization code")
+	}
+	result := *identity
+	return &result, nil
+}
+
+func newTestAuthRepository(t *testing.T, oidc service.OIDCService) *authRespository {
+	if os.Getenv("JWT_SECRET") == "" {
+		os.Setenv("JWT_SECRET", "secret")
+		t.Cleanup(func() { os.Unsetenv("JWT_SECRET") })
+	}
+	client := testDatabase(t)
+	if err := identityIndex(client); err != nil {
+		t.Fatal(err)
+	}
+	return &authRespository{
+		client: client,
+		sessions: &sessionRepository{
+			client:     client,
+			collection: client.Collection(CollectionSessions),
+			jwtService: service.NewJWTService(),
+		},
+		throttle: NewThrottle(NewMemoryAttemptStore()),
+		oidc:     oidc,
+	}
+}
+
+func findTestUser(t *testing.T, db *authRespository, nickname string) *UserSchema {
+	var user UserSchema
+	err := db.client.Collection(CollectionUsers).FindOne(context.TODO(), bson.M{"_id": nickname}).Decode(&user)
+	if err != nil {
+		t.Fatalf("user %s: %v", nickname, err)
+	}
+	return &user
+}
+
+func TestProviderSignup(t *testing.T) {
+	db := newTestAuthRepository(t, stubOIDCService{
+		"code": {Provider: "fake", Subject: "subject", Email: "user@example.com", EmailVerified: true, Name: "User"},
+	})
+	login, err := db.LoginWithProvider("fake", "code", testNonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !login.NicknameRequired || login.Challenge == nil || login.Token != nil {
+		t.Fatalf("unknown identity didn't ask for a nickname: %+v", login)
+	}
+	if _, err := db.CompleteProviderSignup(*login.Challenge, " "); err == nil {
+		t.Error("blank nickname was accepted")
+	}
+	login, err = db.CompleteProviderSignup(*login.Challenge, "User")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if login.Token == nil || !login.First {
+		t.Errorf("signup didn't log in: %+v", login)
+	}
+	user := findTestUser(t, db, "user")
+	if len(user.Identities) != 1 || user.Identities[0] != (Identity{Provider: "fake", Subject: "subject"}) {
+		t.Errorf("identities = %+v", user.Identities)
+	}
+	if !user.EmailVerified || user.Email != "user@example.com" {
+		t.Errorf("email = %q, verified = %v", user.Email, user.EmailVerified)
+	}
+
+	login, err = db.LoginWithProvider("fake", "code", testNonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if login.NicknameRequired || login.Token == nil {
+		t.Errorf("linked identity didn't log in: %+v", login)
+	}
+	challenge := service.NewJWTService().GenerateProviderSignupToken(&service.OIDCIdentity{Provider: "fake", Subject: "subject"})
+	if _, err := db.CompleteProviderSignup(challenge, "other"); err == nil {
+		t.Error("linked identity signed up a second account")
+	}
+	if _, err := db.LoginWithProvider("fake", "code", "other"); err == nil {
+		t.Error("login with the wrong nonce succeeded")
+	}
+}
+
+func TestLinkProvider(t *testing.T) {
+	db := newTestAuthRepository(t, stubOIDCService{
+		"code": {Provider: "fake", Subject: "subject"},
+	})
+	users := db.client.Collection(CollectionUsers)
+	for _, nickname := range []string{"alice", "bob"} {
+		if _, err := users.InsertOne(context.TODO(), &UserSchema{Nickname: nickname}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if ok, err := db.LinkProvider("alice", "fake", "code", testNonce); !ok || err != nil {
+		t.Fatalf("link failed: %v", err)
+	}
+	if ok, err := db.LinkProvider("bob", "fake", "code", testNonce); ok || err == nil {
+		t.Error("identity was linked to a second account")
+	}
+	if len(findTestUser(t, db, "bob").Identities) != 0 {
+		t.Error("bob got the identity of alice")
+	}
+	login, err := db.LoginWithProvider("fake", "code", testNonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if login.Token == nil {
+		t.Errorf("linked identity didn't log in: %+v", login)
+	}
+}
+
+func TestLinkProviderConcurrently(t *testing.T) {
+	db := newTestAuthRepository(t, stubOIDCService{
+		"code": {Provider: "fake", Subject: "subject"},
+	})
+	nicknames := []string{"alice", "bob", "carol", "dave", "erin", "frank", "grace", "heidi"}
+	for _, nickname := range nicknames {
+		if _, err := db.client.Collection(CollectionUsers).InsertOne(context.TODO(), &UserSchema{Nickname: nickname}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	linked := 0
+	for _, nickname := range nicknames {
+		wg.Add(1)
+		go func(nickname string) {
+			defer wg.Done()
+			if ok, _ := db.LinkProvider(nickname, "fake", "code", testNonce); ok {
+				mutex.Lock()
+				linked++
+				mutex.Unlock()
+			}
+		}(nickname)
+	}
+	wg.Wait()
+	if linked != 1 {
+		t.Errorf("identity was linked %d times, want 1", linked)
+	}
+	count, err := db.client.Collection(CollectionUsers).CountDocuments(context.TODO(), identityFilter("fake", "subject"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("%d accounts have the identity, want 1", count)
+	}
+}
@@ -0,0 +1,397 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Recommendation settings
+const (
+	// RecommendationWindow is how old a post can be and still be recommended
+	RecommendationWindow = 14 * 24 * time.Hour
+	// RecommendationPoolSize is how many candidate posts are ranked per request
+	RecommendationPoolSize = 500
+	// RecommendationInteractions is how many of the viewer's likes on recent
+	// posts and of their recent comments are taken into account
+	RecommendationInteractions = 200
+	// SeenPostTTL is how long a post stays hidden after being seen
+	SeenPostTTL = 30 * 24 * time.Hour
+)
+
+// Recommendation weights
+const (
+	TagWeight            = 3.0
+	InteractionWeight    = 2.0
+	FollowOfFollowWeight = 1.5
+	PopularityWeight     = 0.5
+	// signalCap keeps a single busy author from drowning the other signals
+	signalCap = 5
+)
+
+// RecommendationProfile is what is known about the viewer when ranking
+type RecommendationProfile struct {
+	// Tags are the viewer's tags
+	Tags []string
+	// Interactions counts the viewer's likes and comments per author
+	Interactions map[string]int
+	// FollowsOfFollows counts how many accounts the viewer follows follow each author
+	FollowsOfFollows map[string]int
+}
+
+// RecommendationCandidate is a post that may be recommended
+type RecommendationCandidate struct {
+	ID         string
+	Author     string
+	AuthorTags []string
+	LikeCount  int
+	Timestamp  int64
+}
+
+// RankedCandidate struct
+type RankedCandidate struct {
+	RecommendationCandidate
+	Score float64
+}
+
+// RankCandidates scores every candidate against the profile and sorts them
+// best first. It only depends on its arguments, so the same input always
+// produces the same ranking. Ties go to the higher, usually newer, id.
+func RankCandidates(profile *RecommendationProfile, candidates []RecommendationCandidate, now time.Time) []RankedCandidate {
+	viewerTags := make(map[string]bool)
+	for _, t := range profile.Tags {
+		viewerTags[t] = true
+	}
+	ranked := make([]RankedCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		sharedTags := 0
+		for _, t := range c.AuthorTags {
+			if viewerTags[t] {
+				sharedTags++
+			}
+		}
+		score := TagWeight*float64(capSignal(sharedTags)) +
+			InteractionWeight*float64(capSignal(profile.Interactions[c.Author])) +
+			FollowOfFollowWeight*float64(capSignal(profile.FollowsOfFollows[c.Author])) +
+			PopularityWeight*math.Log1p(float64(c.LikeCount))
+		age := now.Sub(time.Unix(c.Timestamp, 0)).Hours()
+		if age < 0 {
+			age = 0
+		}
+		score /= 1 + age/48
+		ranked = append(ranked, RankedCandidate{c, score})
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return rankedBefore(&ranked[i], &ranked[j])
+	})
+	return ranked
+}
+
+func rankedBefore(a, b *RankedCandidate) bool {
+	if a.Score != b.Score {
+		return a.Score > b.Score
+	}
+	return a.ID > b.ID
+}
+
+func capSignal(n int) int {
+	if n > signalCap {
+		return signalCap
+	}
+	return n
+}
+
+// SeenPost struct
+type SeenPost struct {
+	User      string             `bson:"user"`
+	Post      primitive.ObjectID `bson:"post"`
+	ExpiresAt time.Time          `bson:"expiresat"`
+}
+
+func (db *feedRepository) MarkPostsSeen(sender string, postIDs []string) (bool, error) {
+	expiresAt := time.Now().Add(SeenPostTTL)
+	models := make([]mongo.WriteModel, 0, len(postIDs))
+	for _, postID := range postIDs {
+		id, err := primitive.ObjectIDFromHex(postID)
+		if err != nil {
+			return false, errors.New("Invalid postID")
+		}
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"user": sender, "post": id}).
+			SetUpdate(bson.M{"$set": bson.M{"expiresat": expiresAt}}).
+			SetUpsert(true))
+	}
+	if len(models) == 0 {
+		return true, nil
+	}
+	_, err := db.client.Collection(CollectionSeenPosts).BulkWrite(context.TODO(), models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetRecommended ranks recent posts by authors related to the viewer through
+// tags, past interactions or the accounts they follow, leaving out posts the
+// viewer has seen and authors on either side of a block
+func (db *feedRepository) GetRecommended(nickname string, first *int, after *string) (*model.FeedPostConnection, error) {
+	size, err := pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+	c, err := decodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	var afterScore float64
+	if c != nil {
+		afterScore, err = strconv.ParseFloat(c.Key, 64)
+		if err != nil {
+			return nil, errors.New("Invalid cursor")
+		}
+	}
+	result := db.client.Collection(CollectionUsers).FindOne(context.TODO(), bson.M{"_id": nickname})
+	var u UserSchema
+	err = result.Decode(&u)
+	if err != nil {
+		return nil, errors.New("Unexpected Error")
+	}
+	// scores decay with age, so the clock is truncated to keep pages of the
+	// same hour consistent with each other
+	now := time.Now().Truncate(time.Hour)
+	profile, authors, err := db.recommendationProfile(&u)
+	if err != nil {
+		return nil, errors.New("Could not load feed")
+	}
+	candidates, err := db.recommendationCandidates(nickname, authors, now)
+	if err != nil {
+		return nil, errors.New("Could not load feed")
+	}
+	ranked := RankCandidates(profile, candidates, now)
+	if c != nil {
+		cursor := &RankedCandidate{RecommendationCandidate{ID: c.ID}, afterScore}
+		i := sort.Search(len(ranked), func(i int) bool {
+			return rankedBefore(cursor, &ranked[i])
+		})
+		ranked = ranked[i:]
+	}
+	if len(ranked) > size+1 {
+		ranked = ranked[:size+1]
+	}
+	ids := make([]primitive.ObjectID, 0, len(ranked))
+	for _, r := range ranked {
+		id, _ := primitive.ObjectIDFromHex(r.ID)
+		ids = append(ids, id)
+	}
+	found, err := db.findPosts(nickname, bson.M{"_id": bson.M{"$in": ids}}, bson.D{{Key: "_id", Value: -1}}, len(ids)+1)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*feedPost)
+	for _, p := range found {
+		byID[p.ID] = p
+	}
+	posts := make([]*feedPost, 0, len(ranked))
+	scores := make(map[string]string)
+	for _, r := range ranked {
+		if p, ok := byID[r.ID]; ok {
+			posts = append(posts, p)
+			scores[p.ID] = strconv.FormatFloat(r.Score, 'g', -1, 64)
+		}
+	}
+	return newFeedPostConnection(posts, size, c, func(p *feedPost) string {
+		return scores[p.ID]
+	}), nil
+}
+
+// recommendationProfile gathers the viewer's signals and the authors they
+// point to, without the viewer and blocked accounts
+func (db *feedRepository) recommendationProfile(u *UserSchema) (*RecommendationProfile, []string, error) {
+	ctx := context.TODO()
+	profile := &RecommendationProfile{
+		Tags:             make([]string, 0),
+		Interactions:     make(map[string]int),
+		FollowsOfFollows: make(map[string]int),
+	}
+	excluded, err := blockedUsers(db.client, u)
+	if err != nil {
+		return nil, nil, err
+	}
+	excluded[u.Nickname] = true
+	authors := make([]string, 0)
+	related := make(map[string]bool)
+	relate := func(author string) {
+		if !excluded[author] && !related[author] {
+			related[author] = true
+			authors = append(authors, author)
+		}
+	}
+
+	tags := db.client.Collection(CollectionTags)
+	cursor, err := tags.Find(ctx, bson.M{"users": u.Nickname})
+	if err != nil {
+		return nil, nil, err
+	}
+	for cursor.Next(ctx) {
+		var t TagSchema
+		if err := cursor.Decode(&t); err != nil {
+			cursor.Close(ctx)
+			return nil, nil, err
+		}
+		profile.Tags = append(profile.Tags, t.ID)
+		for _, author := range t.Users {
+			relate(author)
+		}
+	}
+	cursor.Close(ctx)
+
+	// likes are stored without the time they were made, so this takes the
+	// likes on the newest posts rather than the viewer's latest likes. A like
+	// on an old post doesn't count, however recent it is.
+	cursor, err = db.client.Collection(CollectionPosts).Find(ctx, bson.M{"likes": u.Nickname}, options.Find().
+		SetSort(bson.D{{Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(RecommendationInteractions).
+		SetProjection(bson.M{"author": 1}))
+	if err != nil {
+		return nil, nil, err
+	}
+	for cursor.Next(ctx) {
+		var p struct {
+			Author string `bson:"author"`
+		}
+		if err := cursor.Decode(&p); err != nil {
+			cursor.Close(ctx)
+			return nil, nil, err
+		}
+		profile.Interactions[p.Author]++
+		relate(p.Author)
+	}
+	cursor.Close(ctx)
+
+	cursor, err = db.client.Collection(CollectionComments).Find(ctx, bson.M{"author": u.Nickname}, options.Find().
+		SetSort(bson.D{{Key: "timestamp", Value: -1}}).
+		SetLimit(RecommendationInteractions).
+		SetProjection(bson.M{"postauthor": 1}))
+	if err != nil {
+		return nil, nil, err
+	}
+	for cursor.Next(ctx) {
+		var c CommentSchema
+		if err := cursor.Decode(&c); err != nil {
+			cursor.Close(ctx)
+			return nil, nil, err
+		}
+		profile.Interactions[c.PostAuthor]++
+		relate(c.PostAuthor)
+	}
+	cursor.Close(ctx)
+
+	if len(u.Following) > 0 {
+		cursor, err = db.client.Collection(CollectionUsers).Find(ctx, bson.M{"_id": bson.M{"$in": u.Following}},
+			options.Find().SetProjection(bson.M{"following": 1}))
+		if err != nil {
+			return nil, nil, err
+		}
+		for cursor.Next(ctx) {
+			var f UserSchema
+			if err := cursor.Decode(&f); err != nil {
+				cursor.Close(ctx)
+				return nil, nil, err
+			}
+			for _, author := range f.Following {
+				profile.FollowsOfFollows[author]++
+				relate(author)
+			}
+		}
+		cursor.Close(ctx)
+	}
+	return profile, authors, nil
+}
+
+// recommendationCandidates loads the recent, unseen posts of authors
+func (db *feedRepository) recommendationCandidates(viewer string, authors []string, now time.Time) ([]RecommendationCandidate, error) {
+	if len(authors) == 0 {
+		return nil, nil
+	}
+	ctx := context.TODO()
+	seen := make([]primitive.ObjectID, 0)
+	cursor, err := db.client.Collection(CollectionSeenPosts).Find(ctx, bson.M{"user": viewer},
+		options.Find().SetProjection(bson.M{"post": 1}))
+	if err != nil {
+		return nil, err
+	}
+	for cursor.Next(ctx) {
+		var s SeenPost
+		if err := cursor.Decode(&s); err != nil {
+			cursor.Close(ctx)
+			return nil, err
+		}
+		seen = append(seen, s.Post)
+	}
+	cursor.Close(ctx)
+
+	since := strconv.FormatInt(now.Add(-RecommendationWindow).Unix(), 10)
+	cursor, err = db.client.Collection(CollectionPosts).Find(ctx, bson.M{
+		"author":    bson.M{"$in": authors},
+		"timestamp": bson.M{"$gte": since},
+		"_id":       bson.M{"$nin": seen},
+	}, options.Find().
+		SetSort(bson.D{{Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(RecommendationPoolSize).
+		SetProjection(bson.M{"author": 1, "likecount": 1, "timestamp": 1}))
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]RecommendationCandidate, 0)
+	postAuthors := make([]string, 0)
+	for cursor.Next(ctx) {
+		var p struct {
+			ID        primitive.ObjectID `bson:"_id"`
+			Author    string             `bson:"author"`
+			LikeCount int                `bson:"likecount"`
+			Timestamp string             `bson:"timestamp"`
+		}
+		if err := cursor.Decode(&p); err != nil {
+			cursor.Close(ctx)
+			return nil, err
+		}
+		timestamp, _ := strconv.ParseInt(p.Timestamp, 10, 64)
+		candidates = append(candidates, RecommendationCandidate{
+			ID:        p.ID.Hex(),
+			Author:    p.Author,
+			LikeCount: p.LikeCount,
+			Timestamp: timestamp,
+		})
+		postAuthors = append(postAuthors, p.Author)
+	}
+	cursor.Close(ctx)
+
+	authorTags := make(map[string][]string)
+	cursor, err = db.client.Collection(CollectionTags).Find(ctx, bson.M{"users": bson.M{"$in": postAuthors}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var t TagSchema
+		if err := cursor.Decode(&t); err != nil {
+			return nil, err
+		}
+		for _, user := range t.Users {
+			authorTags[user] = append(authorTags[user], t.ID)
+		}
+	}
+	for i := range candidates {
+		candidates[i].AuthorTags = authorTags[candidates[i].Author]
+	}
+	return candidates, nil
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+var testNow = time.Unix(1600000000, 0)
+
+func hoursAgo(hours float64) int64 {
+	return testNow.Add(-time.Duration(hours * float64(time.Hour))).Unix()
+}
+
+func TestCapSignal(t *testing.T) {
+	tests := []struct {
+		n    int
+		want int
+	}{
+		{0, 0},
+		{1, 1},
+		{signalCap, signalCap},
+		{signalCap + 1, signalCap},
+		{100, signalCap},
+	}
+	for _, test := range tests {
+		if got := capSignal(test.n); got != test.want {
+			t.Errorf("capSignal(%d) = %d, want %d", test.n, got, test.want)
+		}
+	}
+}
+
+func TestRankCandidatesScore(t *testing.T) {
+	profile := &RecommendationProfile{
+		Tags:             []string{"anime", "ink", "oil", "pixel", "sketch", "watercolor", "3d"},
+		Interactions:     map[string]int{"liked": 3, "fan": 12},
+		FollowsOfFollows: map[string]int{"friend": 2, "famous": 40},
+	}
+	tests := []struct {
+		name      string
+		candidate RecommendationCandidate
+		want      float64
+	}{
+		{"no signal", RecommendationCandidate{Author: "stranger", Timestamp: testNow.Unix()}, 0},
+		{"popularity", RecommendationCandidate{Author: "stranger", LikeCount: 9, Timestamp: testNow.Unix()}, PopularityWeight * math.Log1p(9)},
+		{"shared tags", RecommendationCandidate{Author: "stranger", AuthorTags: []string{"ink", "oil", "clay"}, Timestamp: testNow.Unix()}, 2 * TagWeight},
+		{"shared tags capped", RecommendationCandidate{Author: "stranger", AuthorTags: profile.Tags, Timestamp: testNow.Unix()}, signalCap * TagWeight},
+		{"interactions", RecommendationCandidate{Author: "liked", Timestamp: testNow.Unix()}, 3 * InteractionWeight},
+		{"interactions capped", RecommendationCandidate{Author: "fan", Timestamp: testNow.Unix()}, signalCap * InteractionWeight},
+		{"follows of follows", RecommendationCandidate{Author: "friend", Timestamp: testNow.Unix()}, 2 * FollowOfFollowWeight},
+		{"follows of follows capped", RecommendationCandidate{Author: "famous", Timestamp: testNow.Unix()}, signalCap * FollowOfFollowWeight},
+		{
+			"every signal",
+			RecommendationCandidate{Author: "liked", AuthorTags: []string{"ink"}, LikeCount: 3, Timestamp: testNow.Unix()},
+			TagWeight + 3*InteractionWeight + PopularityWeight*math.Log1p(3),
+		},
+		{"two days old", RecommendationCandidate{Author: "liked", Timestamp: hoursAgo(48)}, 3 * InteractionWeight / 2},
+		{"four days old", RecommendationCandidate{Author: "liked", Timestamp: hoursAgo(96)}, 3 * InteractionWeight / 3},
+		{"twelve hours old", RecommendationCandidate{Author: "liked", Timestamp: hoursAgo(12)}, 3 * InteractionWeight / 1.25},
+		{"from the future", RecommendationCandidate{Author: "liked", Timestamp: hoursAgo(-48)}, 3 * InteractionWeight},
+	}
+	for _, test := range tests {
+		ranked := RankCandidates(profile, []RecommendationCandidate{test.candidate}, testNow)
+		if len(ranked) != 1 {
+			t.Fatalf("%s: ranked %d candidates, want 1", test.name, len(ranked))
+		}
+		if math.Abs(ranked[0].Score-test.want) > 1e-9 {
+			t.Errorf("%s: score = %v, want %v", test.name, ranked[0].Score, test.want)
+		}
+	}
+}
+
+func TestRankCandidatesOrder(t *testing.T) {
+	profile := &RecommendationProfile{
+		Tags:             []string{"ink"},
+		Interactions:     map[string]int{"liked": 1},
+		FollowsOfFollows: map[string]int{"friend": 1},
+	}
+	candidates := []RecommendationCandidate{
+		{ID: "01", Author: "stranger", Timestamp: testNow.Unix()},
+		{ID: "02", Author: "friend", Timestamp: testNow.Unix()},
+		{ID: "03", Author: "tagged", AuthorTags: []string{"ink"}, Timestamp: testNow.Unix()},
+		{ID: "04", Author: "liked", Timestamp: testNow.Unix()},
+		// the same signal as 03, but a week old
+		{ID: "05", Author: "tagged", AuthorTags: []string{"ink"}, Timestamp: hoursAgo(7 * 24)},
+		// ties with 02 and 07, and goes first for its higher id
+		{ID: "06", Author: "friend", Timestamp: testNow.Unix()},
+		{ID: "07", Author: "friend", Timestamp: testNow.Unix()},
+		{ID: "08", Author: "stranger", Timestamp: testNow.Unix()},
+	}
+	want := []string{"03", "04", "07", "06", "02", "05", "08", "01"}
+	ranked := RankCandidates(profile, candidates, testNow)
+	// the ranking mustn't depend on the order of the candidates
+	reversed := make([]RecommendationCandidate, len(candidates))
+	for i, c := range candidates {
+		reversed[len(candidates)-1-i] = c
+	}
+	for _, r := range [][]RankedCandidate{ranked, RankCandidates(profile, reversed, testNow)} {
+		if len(r) != len(want) {
+			t.Fatalf("ranked %d candidates, want %d", len(r), len(want))
+		}
+		for i, id := range want {
+			if r[i].ID != id {
+				t.Errorf("position %d: %s (%v), want %s", i, r[i].ID, r[i].Score, id)
+			}
+		}
+	}
+}
+
+func TestRankCandidatesEmptyProfile(t *testing.T) {
+	ranked := RankCandidates(&RecommendationProfile{}, []RecommendationCandidate{
+		{ID: "01", Author: "a", LikeCount: 1, Timestamp: testNow.Unix()},
+		{ID: "02", Author: "b", LikeCount: 5, Timestamp: testNow.Unix()},
+	}, testNow)
+	if len(ranked) != 2 || ranked[0].ID != "02" {
+		t.Errorf("ranked = %+v, want the more popular post first", ranked)
+	}
+}
@@ -14,13 +14,23 @@ import (
 
 // Database and Collection names
 const (
-	Database           = "cancanvas"
-	CollectionUsers    = "users"
-	CollectionChats    = "chats"
-	CollectionPosts    = "posts"
-	CollectionAuctions = "auctions"
-	CollectionTags     = "tags"
-	CollectionPayments = "payments"
+	Database                 = "cancanvas"
+	CollectionUsers          = "users"
+	CollectionChats          = "chats"
+	CollectionPosts          = "posts"
+	CollectionAuctions       = "auctions"
+	CollectionTags           = "tags"
+	CollectionPayments       = "payments"
+	CollectionSessions       = "sessions"
+	CollectionAttempts       = "attempts"
+	CollectionPasswordResets = "password_resets"
+	CollectionMailOutbox     = "mail_outbox"
+	CollectionTimelines      = "timelines"
+	CollectionTrending       = "trending"
+	CollectionSeenPosts      = "seen_posts"
+	CollectionHashtags       = "hashtags"
+	CollectionNotifications  = "notifications"
+	CollectionComments       = "comments"
 )
 
 func newDatabaseClient() *mongo.Database {
@@ -0,0 +1,523 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Search settings
+const (
+	// MaxSearchQueryLength caps the length of a search query
+	MaxSearchQueryLength = 100
+	// searchTagPoolSize caps how many matching tags are scored per query
+	searchTagPoolSize = 200
+	// searchNicknamePoolSize caps how many nicknames starting with the query
+	// are scored
+	searchNicknamePoolSize = 200
+	// nicknameExactScore and nicknamePrefixScore rank the users whose
+	// nickname is the query or starts with it. A single word scores at most
+	// 3 in a name and 1 in a bio, so the user with the exact nickname comes
+	// first.
+	nicknameExactScore  = 5
+	nicknamePrefixScore = 1.5
+)
+
+// SearchHit is a document matched by a SearchBackend
+type SearchHit struct {
+	Type  model.SearchType
+	ID    string
+	Score float64
+}
+
+// SearchQuery struct
+type SearchQuery struct {
+	Text  string
+	Types []model.SearchType
+	// AuctionStatus lists the statuses of the auctions searched, only open
+	// ones when empty
+	AuctionStatus []model.AuctionStatus
+	// Exclude lists the accounts whose profiles, posts and auctions are left
+	// out of the results
+	Exclude []string
+	// After is the last hit of the previous page
+	After *SearchHit
+	Limit int
+}
+
+// SearchBackend finds the documents matching a query, best first. Hits with
+// the same score are ordered by type and then ID, both descending, so that
+// pages never overlap.
+type SearchBackend interface {
+	Search(query *SearchQuery) ([]*SearchHit, error)
+}
+
+// SearchRepository interface
+type SearchRepository interface {
+	Search(sender, query string, types []model.SearchType, auctionStatus []model.AuctionStatus, first *int, after *string) (*model.SearchHitConnection, error)
+}
+
+type searchRepository struct {
+	client  *mongo.Database
+	backend SearchBackend
+}
+
+type mongoSearchBackend struct {
+	client *mongo.Database
+}
+
+// searchBefore reports whether a is ranked before b
+func searchBefore(a, b *SearchHit) bool {
+	if a.Score != b.Score {
+		return a.Score > b.Score
+	}
+	if a.Type != b.Type {
+		return a.Type > b.Type
+	}
+	return a.ID > b.ID
+}
+
+func encodeSearchCursor(hit *SearchHit) string {
+	return encodeCursor(strconv.FormatFloat(hit.Score, 'g', -1, 64), string(hit.Type)+":"+hit.ID)
+}
+
+// searchCursorHit returns the hit a decoded cursor points at
+func searchCursorHit(c *pageCursor) (*SearchHit, error) {
+	if c == nil {
+		return nil, nil
+	}
+	score, err := strconv.ParseFloat(c.Key, 64)
+	parts := strings.SplitN(c.ID, ":", 2)
+	if err != nil || len(parts) != 2 || !model.SearchType(parts[0]).IsValid() {
+		return nil, errors.New("Invalid cursor")
+	}
+	return &SearchHit{Type: model.SearchType(parts[0]), ID: parts[1], Score: score}, nil
+}
+
+func (db *searchRepository) Search(sender, query string, types []model.SearchType, auctionStatus []model.AuctionStatus, first *int, after *string) (*model.SearchHitConnection, error) {
+	size, err := pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+	c, err := decodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	last, err := searchCursorHit(c)
+	if err != nil {
+		return nil, err
+	}
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, errors.New("Search query can't be empty")
+	}
+	if len(query) > MaxSearchQueryLength {
+		return nil, errors.New("Search query is too long")
+	}
+	if len(types) == 0 {
+		types = model.AllSearchType
+	}
+	for _, status := range auctionStatus {
+		if !status.IsValid() {
+			return nil, errors.New("Invalid auction status")
+		}
+	}
+	var u UserSchema
+	err = db.client.Collection(CollectionUsers).FindOne(context.TODO(), bson.M{"_id": sender}).Decode(&u)
+	if err != nil {
+		return nil, errors.New("User not found")
+	}
+	blocked, err := blockedUsers(db.client, &u)
+	if err != nil {
+		return nil, errors.New("Could not search")
+	}
+	exclude := make([]string, 0, len(blocked))
+	for nickname := range blocked {
+		exclude = append(exclude, nickname)
+	}
+	hits, err := db.backend.Search(&SearchQuery{
+		Text:          query,
+		Types:         types,
+		AuctionStatus: auctionStatus,
+		Exclude:       exclude,
+		After:         last,
+		Limit:         size + 1,
+	})
+	if err != nil {
+		return nil, errors.New("Could not search")
+	}
+	hasNextPage := len(hits) > size
+	if hasNextPage {
+		hits = hits[:size]
+	}
+	results, err := db.load(hits, sender)
+	if err != nil {
+		return nil, errors.New("Could not search")
+	}
+	connection := &model.SearchHitConnection{
+		Edges: make([]*model.SearchHitEdge, 0),
+	}
+	for _, hit := range hits {
+		result, ok := results[string(hit.Type)+":"+hit.ID]
+		if !ok {
+			continue
+		}
+		connection.Edges = append(connection.Edges, &model.SearchHitEdge{
+			Cursor: encodeSearchCursor(hit),
+			Node: &model.SearchHit{
+				Score:  hit.Score,
+				Result: result,
+			},
+		})
+	}
+	connection.PageInfo = newPageInfo(c, hasNextPage, len(connection.Edges), func(i int) string {
+		return connection.Edges[i].Cursor
+	})
+	return connection, nil
+}
+
+// load fetches the documents behind hits, keyed by type and ID. Documents
+// deleted since they were indexed are missing from the result.
+func (db *searchRepository) load(hits []*SearchHit, viewer string) (map[string]model.SearchResult, error) {
+	ids := make(map[model.SearchType][]string)
+	for _, hit := range hits {
+		ids[hit.Type] = append(ids[hit.Type], hit.ID)
+	}
+	results := make(map[string]model.SearchResult)
+	ctx := context.TODO()
+	if len(ids[model.SearchTypeUser]) > 0 {
+		cursor, err := db.client.Collection(CollectionUsers).Find(ctx, bson.M{"_id": bson.M{"$in": ids[model.SearchTypeUser]}})
+		if err != nil {
+			return nil, err
+		}
+		for cursor.Next(ctx) {
+			var u UserSchema
+			if err := cursor.Decode(&u); err != nil {
+				cursor.Close(ctx)
+				return nil, err
+			}
+			results[string(model.SearchTypeUser)+":"+u.Nickname] = newUserModel(&u, viewer)
+		}
+		cursor.Close(ctx)
+	}
+	if len(ids[model.SearchTypePost]) > 0 {
+		cursor, err := db.lookup(CollectionPosts, "author", ids[model.SearchTypePost], likedBy(viewer)...)
+		if err != nil {
+			return nil, err
+		}
+		for cursor.Next(ctx) {
+			var p feedPost
+			if err := cursor.Decode(&p); err != nil {
+				cursor.Close(ctx)
+				return nil, err
+			}
+			if len(p.Author) > 0 {
+				results[string(model.SearchTypePost)+":"+p.ID] = newFeedPostModel(&p)
+			}
+		}
+		cursor.Close(ctx)
+	}
+	if len(ids[model.SearchTypeAuction]) > 0 {
+		cursor, err := db.lookup(CollectionAuctions, "host", ids[model.SearchTypeAuction])
+		if err != nil {
+			return nil, err
+		}
+		for cursor.Next(ctx) {
+			var a feedAuction
+			if err := cursor.Decode(&a); err != nil {
+				cursor.Close(ctx)
+				return nil, err
+			}
+			if len(a.Host) > 0 {
+				results[string(model.SearchTypeAuction)+":"+a.ID] = newFeedAuctionModel(&a, viewer)
+			}
+		}
+		cursor.Close(ctx)
+	}
+	if len(ids[model.SearchTypeTag]) > 0 {
+		cursor, err := db.client.Collection(CollectionTags).Find(ctx, bson.M{"_id": bson.M{"$in": ids[model.SearchTypeTag]}})
+		if err != nil {
+			return nil, err
+		}
+		for cursor.Next(ctx) {
+			var t TagSchema
+			if err := cursor.Decode(&t); err != nil {
+				cursor.Close(ctx)
+				return nil, err
+			}
+			results[string(model.SearchTypeTag)+":"+t.ID] = &model.Tag{Name: t.ID, Users: len(t.Users)}
+		}
+		cursor.Close(ctx)
+	}
+	return results, nil
+}
+
+// lookup loads the documents of collection with the given hex ids, joining
+// the user in field after the extra stages
+func (db *searchRepository) lookup(collection, field string, hexIDs []string, stages ...bson.D) (*mongo.Cursor, error) {
+	ids := make([]primitive.ObjectID, 0, len(hexIDs))
+	for _, hexID := range hexIDs {
+		id, err := primitive.ObjectIDFromHex(hexID)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	pipeline := append(mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.M{"_id": bson.M{"$in": ids}}}},
+	}, stages...)
+	pipeline = append(pipeline, bson.D{{
+		Key: "$lookup",
+		Value: bson.M{
+			"from":         CollectionUsers,
+			"localField":   field,
+			"foreignField": "_id",
+			"as":           field,
+		}}})
+	return db.client.Collection(collection).Aggregate(context.TODO(), pipeline)
+}
+
+func (b *mongoSearchBackend) Search(query *SearchQuery) ([]*SearchHit, error) {
+	hits := make([]*SearchHit, 0)
+	seen := make(map[model.SearchType]bool)
+	excluded := query.Exclude
+	if excluded == nil {
+		excluded = make([]string, 0)
+	}
+	exclude := bson.M{"$nin": excluded}
+	for _, t := range query.Types {
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		var found []*SearchHit
+		var err error
+		switch t {
+		case model.SearchTypeUser:
+			found, err = b.searchUsers(excluded, query)
+		case model.SearchTypePost:
+			found, err = b.searchText(CollectionPosts, t, bson.M{"author": exclude}, query)
+		case model.SearchTypeAuction:
+			status := query.AuctionStatus
+			if len(status) == 0 {
+				status = []model.AuctionStatus{model.AuctionStatusOpen}
+			}
+			found, err = b.searchText(CollectionAuctions, t, bson.M{"host": exclude, "status": bson.M{"$in": status}}, query)
+		case model.SearchTypeTag:
+			found, err = b.searchTags(query)
+		default:
+			err = errors.New("Invalid search type")
+		}
+		if err != nil {
+			return nil, err
+		}
+		hits = append(hits, found...)
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		return searchBefore(hits[i], hits[j])
+	})
+	if len(hits) > query.Limit {
+		hits = hits[:query.Limit]
+	}
+	return hits, nil
+}
+
+// searchUsers matches names and bios through the text index, and nicknames
+// by prefix since the text index only matches whole words. Nicknames are
+// lowercase without spaces, so only single word queries can match one. The
+// users matched by nickname are left out of the text search so that every
+// user is ranked once.
+func (b *mongoSearchBackend) searchUsers(excluded []string, query *SearchQuery) ([]*SearchHit, error) {
+	words := strings.Fields(strings.ToLower(query.Text))
+	if len(words) != 1 {
+		return b.searchText(CollectionUsers, model.SearchTypeUser, bson.M{"_id": bson.M{"$nin": excluded}}, query)
+	}
+	prefix := primitive.Regex{Pattern: "^" + regexp.QuoteMeta(words[0])}
+	ctx := context.TODO()
+	cursor, err := b.client.Collection(CollectionUsers).Find(ctx,
+		bson.M{"_id": bson.M{"$regex": prefix, "$nin": excluded}},
+		options.Find().SetSort(bson.M{"_id": 1}).SetLimit(searchNicknamePoolSize).SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	hits := make([]*SearchHit, 0)
+	for cursor.Next(ctx) {
+		var u UserSchema
+		if err := cursor.Decode(&u); err != nil {
+			return nil, err
+		}
+		hit := &SearchHit{Type: model.SearchTypeUser, ID: u.Nickname, Score: NicknameScore(u.Nickname, words[0])}
+		if query.After == nil || searchBefore(query.After, hit) {
+			hits = append(hits, hit)
+		}
+	}
+	found, err := b.searchText(CollectionUsers, model.SearchTypeUser, bson.M{"_id": bson.M{"$nin": excluded, "$not": prefix}}, query)
+	if err != nil {
+		return nil, err
+	}
+	return append(hits, found...), nil
+}
+
+// NicknameScore rates a nickname starting with word, on the scale of the
+// text index scores
+func NicknameScore(nickname, word string) float64 {
+	if nickname == word {
+		return nicknameExactScore
+	}
+	return nicknamePrefixScore
+}
+
+// searchText runs query against the text index of collection
+func (b *mongoSearchBackend) searchText(collection string, t model.SearchType, filter bson.M, query *SearchQuery) ([]*SearchHit, error) {
+	filter["$text"] = bson.M{"$search": query.Text}
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: filter}},
+		bson.D{{Key: "$project", Value: bson.M{"_id": 1, "searchscore": bson.M{"$meta": "textScore"}}}},
+	}
+	if after := query.After; after != nil {
+		// ties on the score continue with the lower types and ids
+		or := []bson.M{{"searchscore": bson.M{"$lt": after.Score}}}
+		switch {
+		case after.Type == t:
+			var id interface{} = after.ID
+			if t != model.SearchTypeUser {
+				objectID, err := primitive.ObjectIDFromHex(after.ID)
+				if err != nil {
+					return nil, errors.New("Invalid cursor")
+				}
+				id = objectID
+			}
+			or = append(or, bson.M{"searchscore": after.Score, "_id": bson.M{"$lt": id}})
+		case t < after.Type:
+			or = append(or, bson.M{"searchscore": after.Score})
+		}
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"$or": or}}})
+	}
+	pipeline = append(pipeline,
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "searchscore", Value: -1}, {Key: "_id", Value: -1}}}},
+		bson.D{{Key: "$limit", Value: query.Limit}},
+	)
+	ctx := context.TODO()
+	cursor, err := b.client.Collection(collection).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	hits := make([]*SearchHit, 0)
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID    interface{} `bson:"_id"`
+			Score float64     `bson:"searchscore"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		hit := &SearchHit{Type: t, Score: doc.Score}
+		switch id := doc.ID.(type) {
+		case primitive.ObjectID:
+			hit.ID = id.Hex()
+		case string:
+			hit.ID = id
+		default:
+			continue
+		}
+		hits = append(hits, hit)
+	}
+	return hits, nil
+}
+
+// searchTags matches tag names containing any word of the query. Tags are
+// few and short, so they are scored here instead of through a text index.
+func (b *mongoSearchBackend) searchTags(query *SearchQuery) ([]*SearchHit, error) {
+	words := strings.Fields(strings.ToLower(query.Text))
+	patterns := make([]string, 0, len(words))
+	for _, w := range words {
+		patterns = append(patterns, regexp.QuoteMeta(w))
+	}
+	ctx := context.TODO()
+	cursor, err := b.client.Collection(CollectionTags).Find(ctx,
+		bson.M{"_id": bson.M{"$regex": strings.Join(patterns, "|"), "$options": "i"}},
+		options.Find().SetLimit(searchTagPoolSize).SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	hits := make([]*SearchHit, 0)
+	for cursor.Next(ctx) {
+		var t TagSchema
+		if err := cursor.Decode(&t); err != nil {
+			return nil, err
+		}
+		hit := &SearchHit{Type: model.SearchTypeTag, ID: t.ID, Score: TagScore(t.ID, words)}
+		if query.After == nil || searchBefore(query.After, hit) {
+			hits = append(hits, hit)
+		}
+	}
+	return hits, nil
+}
+
+// TagScore rates how well tag matches the lowercased words of a query, on
+// roughly the scale of Mongo text scores: exact matches beat prefixes, which
+// beat matches anywhere in the name
+func TagScore(tag string, words []string) float64 {
+	tag = strings.ToLower(tag)
+	score := 0.0
+	for _, w := range words {
+		switch {
+		case tag == w:
+			score += 1.5
+		case strings.HasPrefix(tag, w):
+			score += 1
+		case strings.Contains(tag, w):
+			score += 0.5
+		}
+	}
+	return score
+}
+
+// NewMongoSearchBackend searches the text indexes it creates on users, posts
+// and auctions. Stemming is turned off since content is written in several
+// languages.
+func NewMongoSearchBackend() SearchBackend {
+	return newMongoSearchBackend(newDatabaseClient())
+}
+
+func newMongoSearchBackend(client *mongo.Database) *mongoSearchBackend {
+	ctx := context.TODO()
+	textIndex := func(weights bson.M) *options.IndexOptions {
+		return options.Index().SetDefaultLanguage("none").SetWeights(weights)
+	}
+	client.Collection(CollectionUsers).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "name", Value: "text"}, {Key: "bio", Value: "text"}},
+		Options: textIndex(bson.M{"name": 3, "bio": 1}),
+	})
+	client.Collection(CollectionPosts).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "description", Value: "text"}},
+		Options: textIndex(bson.M{"description": 1}),
+	})
+	client.Collection(CollectionAuctions).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "description", Value: "text"}},
+		Options: textIndex(bson.M{"description": 1}),
+	})
+	return &mongoSearchBackend{
+		client,
+	}
+}
+
+// NewSearchRepository function
+func NewSearchRepository() SearchRepository {
+	return &searchRepository{
+		client:  newDatabaseClient(),
+		backend: NewMongoSearchBackend(),
+	}
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func searchTestIDs(t *testing.T, backend *mongoSearchBackend, query *SearchQuery) []string {
+	if query.Limit == 0 {
+		query.Limit = 10
+	}
+	hits, err := backend.Search(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make([]string, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.ID)
+	}
+	return ids
+}
+
+func TestSearchNickname(t *testing.T) {
+	backend := newMongoSearchBackend(testDatabase(t))
+	for _, u := range []*UserSchema{
+		{Nickname: "painter", Name: "Ann"},
+		{Nickname: "painterly", Name: "Bob"},
+		{Nickname: "sculptor", Name: "Painter"},
+		{Nickname: "blocked", Name: "Painter"},
+	} {
+		if _, err := backend.client.Collection(CollectionUsers).InsertOne(context.TODO(), u); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ids := searchTestIDs(t, backend, &SearchQuery{
+		Text:    "Painter",
+		Types:   []model.SearchType{model.SearchTypeUser},
+		Exclude: []string{"blocked"},
+	})
+	if len(ids) != 3 || ids[0] != "painter" {
+		t.Errorf("hits = %v, want painter first of 3", ids)
+	}
+	ids = searchTestIDs(t, backend, &SearchQuery{Text: "paint", Types: []model.SearchType{model.SearchTypeUser}})
+	if len(ids) != 2 {
+		t.Errorf("hits = %v, want the 2 nicknames starting with paint", ids)
+	}
+	// pages don't repeat users matched both by nickname and by name
+	first := searchTestIDs(t, backend, &SearchQuery{Text: "painter", Types: []model.SearchType{model.SearchTypeUser}, Limit: 1})
+	hits, _ := backend.Search(&SearchQuery{Text: "painter", Types: []model.SearchType{model.SearchTypeUser}, Limit: 1})
+	rest := searchTestIDs(t, backend, &SearchQuery{Text: "painter", Types: []model.SearchType{model.SearchTypeUser}, After: hits[0]})
+	for _, id := range rest {
+		if id == first[0] {
+			t.Errorf("%s is on both pages", id)
+		}
+	}
+	if len(rest) != 3 {
+		t.Errorf("second page = %v, want 3 hits", rest)
+	}
+}
+
+func TestSearchAuctionStatus(t *testing.T) {
+	backend := newMongoSearchBackend(testDatabase(t))
+	for _, status := range []model.AuctionStatus{model.AuctionStatusOpen, model.AuctionStatusCompleted} {
+		_, err := backend.client.Collection(CollectionAuctions).InsertOne(context.TODO(), bson.M{
+			"host":        "host",
+			"description": "portrait",
+			"status":      status,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	status := func(ids []string) []model.AuctionStatus {
+		statuses := make([]model.AuctionStatus, 0, len(ids))
+		for _, id := range ids {
+			statuses = append(statuses, findTestAuction(t, &auctionRepository{client: backend.client}, id).Status)
+		}
+		return statuses
+	}
+	ids := searchTestIDs(t, backend, &SearchQuery{Text: "portrait", Types: []model.SearchType{model.SearchTypeAuction}})
+	if got := status(ids); len(got) != 1 || got[0] != model.AuctionStatusOpen {
+		t.Errorf("statuses = %v, want only the open auction", got)
+	}
+	ids = searchTestIDs(t, backend, &SearchQuery{
+		Text:          "portrait",
+		Types:         []model.SearchType{model.SearchTypeAuction},
+		AuctionStatus: []model.AuctionStatus{model.AuctionStatusCompleted},
+	})
+	if got := status(ids); len(got) != 1 || got[0] != model.AuctionStatusCompleted {
+		t.Errorf("statuses = %v, want only the completed auction", got)
+	}
+}
@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"github.com/eaemenkkstudios/cancanvas-backend/service"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SessionRepository interface
+type SessionRepository interface {
+	CreateSession(user string) (*model.Login, error)
+	RefreshSession(refreshToken string) (*model.Login, error)
+	RevokeSession(user, sessionID string) (bool, error)
+	RevokeAllSessions(user string) (bool, error)
+	IsSessionActive(sessionID string) bool
+}
+
+type sessionRepository struct {
+	client     *mongo.Database
+	collection *mongo.Collection
+	jwtService service.JWTService
+}
+
+// SessionTTL is how long a refresh token stays valid without being used
+const SessionTTL = 30 * 24 * time.Hour
+
+// Session struct
+type Session struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	User         string             `bson:"user"`
+	RefreshHash  string             `bson:"refreshhash"`
+	PreviousHash string             `bson:"previoushash"`
+	Revoked      bool               `bson:"revoked"`
+	CreatedAt    time.Time          `bson:"createdat"`
+	LastUsedAt   time.Time          `bson:"lastusedat"`
+	ExpiresAt    time.Time          `bson:"expiresat"`
+}
+
+func newOpaqueToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+func (db *sessionRepository) getRole(user string) model.Role {
+	result := db.client.Collection(CollectionUsers).FindOne(context.TODO(), bson.M{"_id": user},
+		options.FindOne().SetProjection(bson.M{"role": 1}))
+	var u UserSchema
+	if err := result.Decode(&u); err != nil || !u.Role.IsValid() {
+		return model.RoleUser
+	}
+	return u.Role
+}
+
+func (db *sessionRepository) CreateSession(user string) (*model.Login, error) {
+	refreshToken, err := newOpaqueToken()
+	if err != nil {
+		return nil, errors.New("Could not create session")
+	}
+	now := time.Now()
+	result, err := db.collection.InsertOne(context.TODO(), &Session{
+		User:        user,
+		RefreshHash: hashToken(refreshToken),
+		CreatedAt:   now,
+		LastUsedAt:  now,
+		ExpiresAt:   now.Add(SessionTTL),
+	})
+	if err != nil {
+		return nil, errors.New("Could not create session")
+	}
+	sessionID := result.InsertedID.(primitive.ObjectID).Hex()
+	token := db.jwtService.GenerateToken(user, sessionID, db.getRole(user).String())
+	return &model.Login{
+		Token:        &token,
+		RefreshToken: &refreshToken,
+	}, nil
+}
+
+func (db *sessionRepository) RefreshSession(refreshToken string) (*model.Login, error) {
+	newToken, err := newOpaqueToken()
+	if err != nil {
+		return nil, errors.New("Could not refresh session")
+	}
+	hash := hashToken(refreshToken)
+	now := time.Now()
+	result := db.collection.FindOneAndUpdate(context.TODO(), bson.M{
+		"refreshhash": hash,
+		"revoked":     false,
+		"expiresat":   bson.M{"$gt": now},
+	}, bson.M{
+		"$set": bson.M{
+			"refreshhash":  hashToken(newToken),
+			"previoushash": hash,
+			"lastusedat":   now,
+			"expiresat":    now.Add(SessionTTL),
+		},
+	}, options.FindOneAndUpdate().SetReturnDocument(options.After))
+	var s Session
+	err = result.Decode(&s)
+	if err != nil {
+		// A rotated token being presented again means it leaked, so the
+		// whole session is revoked.
+		db.collection.UpdateOne(context.TODO(), bson.M{"previoushash": hash}, bson.M{
+			"$set": bson.M{"revoked": true},
+		})
+		return nil, errors.New("Unauthorized")
+	}
+	token := db.jwtService.GenerateToken(s.User, s.ID.Hex(), db.getRole(s.User).String())
+	return &model.Login{
+		Token:        &token,
+		RefreshToken: &newToken,
+	}, nil
+}
+
+func (db *sessionRepository) RevokeSession(user, sessionID string) (bool, error) {
+	id, err := primitive.ObjectIDFromHex(sessionID)
+	if err != nil {
+		return false, errors.New("Invalid session")
+	}
+	_, err = db.collection.UpdateOne(context.TODO(), bson.M{"_id": id, "user": user}, bson.M{
+		"$set": bson.M{"revoked": true},
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (db *sessionRepository) RevokeAllSessions(user string) (bool, error) {
+	_, err := db.collection.UpdateMany(context.TODO(), bson.M{"user": user, "revoked": false}, bson.M{
+		"$set": bson.M{"revoked": true},
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (db *sessionRepository) IsSessionActive(sessionID string) bool {
+	id, err := primitive.ObjectIDFromHex(sessionID)
+	if err != nil {
+		return false
+	}
+	count, err := db.collection.CountDocuments(context.TODO(), bson.M{
+		"_id":       id,
+		"revoked":   false,
+		"expiresat": bson.M{"$gt": time.Now()},
+	})
+	return err == nil && count > 0
+}
+
+// NewSessionRepository function
+func NewSessionRepository() SessionRepository {
+	client := newDatabaseClient()
+	collection := client.Collection(CollectionSessions)
+	collection.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.M{"refreshhash": 1}},
+		{Keys: bson.M{"previoushash": 1}},
+		{Keys: bson.M{"user": 1}},
+		{Keys: bson.M{"expiresat": 1}, Options: options.Index().SetExpireAfterSeconds(0)},
+	})
+	return &sessionRepository{
+		client:     client,
+		collection: collection,
+		jwtService: service.NewJWTService(),
+	}
+}
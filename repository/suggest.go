@@ -0,0 +1,278 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Suggestion settings
+const (
+	// NearbyRadiusKm is how close a user must be to count as nearby
+	NearbyRadiusKm = 50.0
+	// SuggestionActivityWindow is how far back posts count as recent activity
+	SuggestionActivityWindow = 7 * 24 * time.Hour
+	// maxReasonDetails caps how many names or tags a reason lists
+	maxReasonDetails = 3
+)
+
+type suggestion struct {
+	nickname   string
+	followsYou bool
+	followedBy []string
+	tags       []string
+	distanceKm float64
+	nearby     bool
+	posts      int
+	followers  int
+	score      float64
+}
+
+func (s *suggestion) rank() {
+	s.score = 2*float64(capSignal(len(s.followedBy))) +
+		1.5*float64(capSignal(len(s.tags))) +
+		math.Log1p(float64(s.posts))
+	if s.followsYou {
+		s.score += 3
+	}
+	if s.nearby {
+		s.score += 2 * (1 - s.distanceKm/NearbyRadiusKm)
+	}
+}
+
+// SuggestUsers ranks accounts the viewer doesn't follow yet by whether they
+// follow the viewer, how many of the viewer's follows follow them, shared
+// tags, distance and recent posts
+func (db *userRepository) SuggestUsers(sender string, first *int) ([]*model.UserSuggestion, error) {
+	size, err := pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+	result := db.collection.FindOne(context.TODO(), bson.M{"_id": sender})
+	var u UserSchema
+	err = result.Decode(&u)
+	if err != nil {
+		return nil, errors.New("User not found")
+	}
+	excluded, err := blockedUsers(db.client, &u)
+	if err != nil {
+		return nil, errors.New("Could not load suggestions")
+	}
+	excluded[u.Nickname] = true
+	for _, f := range u.Following {
+		excluded[f] = true
+	}
+	candidates := make(map[string]*suggestion)
+	candidate := func(nickname string) *suggestion {
+		if excluded[nickname] {
+			return nil
+		}
+		s, ok := candidates[nickname]
+		if !ok {
+			s = &suggestion{nickname: nickname}
+			candidates[nickname] = s
+		}
+		return s
+	}
+	ctx := context.TODO()
+
+	for _, nickname := range u.Followers {
+		if s := candidate(nickname); s != nil {
+			s.followsYou = true
+		}
+	}
+
+	if len(u.Following) > 0 {
+		cursor, err := db.collection.Find(ctx, bson.M{"_id": bson.M{"$in": u.Following}},
+			options.Find().SetProjection(bson.M{"following": 1}))
+		if err != nil {
+			return nil, errors.New("Could not load suggestions")
+		}
+		for cursor.Next(ctx) {
+			var f UserSchema
+			if err := cursor.Decode(&f); err != nil {
+				cursor.Close(ctx)
+				return nil, err
+			}
+			for _, nickname := range f.Following {
+				if s := candidate(nickname); s != nil {
+					s.followedBy = append(s.followedBy, f.Nickname)
+				}
+			}
+		}
+		cursor.Close(ctx)
+	}
+
+	cursor, err := db.client.Collection(CollectionTags).Find(ctx, bson.M{"users": u.Nickname})
+	if err != nil {
+		return nil, errors.New("Could not load suggestions")
+	}
+	for cursor.Next(ctx) {
+		var t TagSchema
+		if err := cursor.Decode(&t); err != nil {
+			cursor.Close(ctx)
+			return nil, err
+		}
+		for _, nickname := range t.Users {
+			if s := candidate(nickname); s != nil {
+				s.tags = append(s.tags, t.ID)
+			}
+		}
+	}
+	cursor.Close(ctx)
+
+	if lat, lng := u.PublicLocation(u.Nickname); lat != nil {
+		within, _ := withinRegion("publiclocation", &model.RegionFilter{Lat: *lat, Lng: *lng, RadiusKm: NearbyRadiusKm})
+		cursor, err := db.collection.Find(ctx, bson.M{"$and": []bson.M{locatable(), within}}, options.Find().
+			SetLimit(RecommendationPoolSize).
+			SetProjection(bson.M{"publiclocation": 1}))
+		if err != nil {
+			return nil, errors.New("Could not load suggestions")
+		}
+		for cursor.Next(ctx) {
+			var n UserSchema
+			if err := cursor.Decode(&n); err != nil {
+				cursor.Close(ctx)
+				return nil, err
+			}
+			nLat, nLng := n.PublicLocation(u.Nickname)
+			if nLat == nil {
+				continue
+			}
+			distance := distanceKm(*lat, *lng, *nLat, *nLng)
+			if distance > NearbyRadiusKm {
+				distance = NearbyRadiusKm
+			}
+			if s := candidate(n.Nickname); s != nil {
+				s.nearby = true
+				s.distanceKm = distance
+			}
+		}
+		cursor.Close(ctx)
+	}
+
+	nicknames := make([]string, 0, len(candidates))
+	for nickname := range candidates {
+		nicknames = append(nicknames, nickname)
+	}
+	if len(nicknames) == 0 {
+		return make([]*model.UserSuggestion, 0), nil
+	}
+	since := strconv.FormatInt(time.Now().Add(-SuggestionActivityWindow).Unix(), 10)
+	cursor, err = db.client.Collection(CollectionPosts).Aggregate(ctx, []bson.M{
+		{"$match": bson.M{"author": bson.M{"$in": nicknames}, "timestamp": bson.M{"$gte": since}}},
+		{"$group": bson.M{"_id": "$author", "count": bson.M{"$sum": 1}}},
+	})
+	if err != nil {
+		return nil, errors.New("Could not load suggestions")
+	}
+	for cursor.Next(ctx) {
+		var activity struct {
+			Author string `bson:"_id"`
+			Count  int    `bson:"count"`
+		}
+		if err := cursor.Decode(&activity); err != nil {
+			cursor.Close(ctx)
+			return nil, err
+		}
+		candidates[activity.Author].posts = activity.Count
+	}
+	cursor.Close(ctx)
+
+	users := make(map[string]*UserSchema)
+	cursor, err = db.collection.Find(ctx, bson.M{"_id": bson.M{"$in": nicknames}},
+		options.Find().SetProjection(bson.M{"name": 1, "picture": 1, "followerscount": 1}))
+	if err != nil {
+		return nil, errors.New("Could not load suggestions")
+	}
+	for cursor.Next(ctx) {
+		var user UserSchema
+		if err := cursor.Decode(&user); err != nil {
+			cursor.Close(ctx)
+			return nil, err
+		}
+		users[user.Nickname] = &user
+	}
+	cursor.Close(ctx)
+
+	ranked := make([]*suggestion, 0, len(users))
+	for nickname, user := range users {
+		s := candidates[nickname]
+		s.followers = user.FollowersCount
+		s.rank()
+		ranked = append(ranked, s)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		if ranked[i].followers != ranked[j].followers {
+			return ranked[i].followers > ranked[j].followers
+		}
+		return ranked[i].nickname < ranked[j].nickname
+	})
+	if len(ranked) > size {
+		ranked = ranked[:size]
+	}
+	suggestions := make([]*model.UserSuggestion, 0, len(ranked))
+	for _, s := range ranked {
+		user := users[s.nickname]
+		suggestions = append(suggestions, &model.UserSuggestion{
+			User: &model.FeedUser{
+				Nickname: user.Nickname,
+				Name:     user.Name,
+				Picture:  user.Picture,
+			},
+			Score:   s.score,
+			Reasons: s.reasons(),
+		})
+	}
+	return suggestions, nil
+}
+
+func (s *suggestion) reasons() []*model.SuggestionReason {
+	reasons := make([]*model.SuggestionReason, 0)
+	if s.followsYou {
+		reasons = append(reasons, &model.SuggestionReason{Code: model.SuggestionReasonCodeFollowsYou})
+	}
+	for i, nickname := range s.followedBy {
+		if i == maxReasonDetails {
+			break
+		}
+		detail := nickname
+		reasons = append(reasons, &model.SuggestionReason{Code: model.SuggestionReasonCodeFollowedBy, Detail: &detail})
+	}
+	for i, tag := range s.tags {
+		if i == maxReasonDetails {
+			break
+		}
+		detail := tag
+		reasons = append(reasons, &model.SuggestionReason{Code: model.SuggestionReasonCodeSharesTag, Detail: &detail})
+	}
+	if s.nearby {
+		detail := strconv.FormatFloat(math.Round(s.distanceKm), 'f', 0, 64) + " km"
+		reasons = append(reasons, &model.SuggestionReason{Code: model.SuggestionReasonCodeNearby, Detail: &detail})
+	}
+	if s.posts > 0 {
+		detail := strconv.Itoa(s.posts)
+		reasons = append(reasons, &model.SuggestionReason{Code: model.SuggestionReasonCodeActive, Detail: &detail})
+	}
+	return reasons
+}
+
+// distanceKm returns the great-circle distance between two points
+func distanceKm(lat1, lng1, lat2, lng2 float64) float64 {
+	toRadians := math.Pi / 180
+	dLat := (lat2 - lat1) * toRadians
+	dLng := (lng2 - lng1) * toRadians
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*toRadians)*math.Cos(lat2*toRadians)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadiusKm * math.Asin(math.Sqrt(a))
+}
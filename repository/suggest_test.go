@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+)
+
+func TestSuggestionFollowsYou(t *testing.T) {
+	follower := &suggestion{nickname: "follower", followsYou: true}
+	stranger := &suggestion{nickname: "stranger", followedBy: []string{"a"}}
+	follower.rank()
+	stranger.rank()
+	if follower.score <= stranger.score {
+		t.Errorf("follower scored %v, user followed by one follow %v", follower.score, stranger.score)
+	}
+	reasons := follower.reasons()
+	if len(reasons) != 1 || reasons[0].Code != model.SuggestionReasonCodeFollowsYou || reasons[0].Detail != nil {
+		t.Errorf("reasons = %+v", reasons)
+	}
+	for _, reason := range stranger.reasons() {
+		if reason.Code == model.SuggestionReasonCodeFollowsYou {
+			t.Error("stranger follows the viewer")
+		}
+	}
+}
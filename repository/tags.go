@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"strconv"
 
 	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
 	"go.mongodb.org/mongo-driver/bson"
@@ -14,7 +15,7 @@ import (
 type TagsRepository interface {
 	GetTags() ([]string, error)
 	GetUserTags(nickname string) ([]string, error)
-	GetUsersPerTags(tags []string, page *int) ([]*model.User, error)
+	GetUsersPerTags(tags []string, first *int, after *string) (*model.UserConnection, error)
 	UpdateUserTags(user string, tags []string) (bool, error)
 	AddTagToUser(user, tag string) (bool, error)
 	RemoveTagFromUser(user, tag string) (bool, error)
@@ -64,9 +65,14 @@ func (db *tagsRepository) GetUserTags(nickname string) ([]string, error) {
 	return tags, err
 }
 
-func (db *tagsRepository) GetUsersPerTags(tags []string, page *int) ([]*model.User, error) {
-	if page == nil || *page < 1 {
-		*page = 1
+func (db *tagsRepository) GetUsersPerTags(tags []string, first *int, after *string) (*model.UserConnection, error) {
+	size, err := pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+	c, err := decodeCursor(after)
+	if err != nil {
+		return nil, err
 	}
 	collection := db.client.Collection(CollectionTags)
 	ctx := context.TODO()
@@ -93,36 +99,47 @@ func (db *tagsRepository) GetUsersPerTags(tags []string, page *int) ([]*model.Us
 		}
 	}
 
+	filter := bson.M{"_id": bson.M{"$in": users}}
+	if c != nil {
+		followersCount, err := strconv.Atoi(c.Key)
+		if err != nil {
+			return nil, errors.New("Invalid cursor")
+		}
+		filter = bson.M{"$and": []bson.M{filter, keysetAfter("followerscount", followersCount, c.ID)}}
+	}
 	collection = db.client.Collection(CollectionUsers)
 	opts := options.Find().
-		SetSkip(int64(PageSize * (*page - 1))).
-		SetLimit(PageSize).
-		SetSort(bson.M{"followerscount": -1})
+		SetLimit(int64(size + 1)).
+		SetSort(bson.D{{Key: "followerscount", Value: -1}, {Key: "_id", Value: -1}})
 	usersCtx := context.TODO()
-	usersCursor, err := collection.Find(usersCtx, bson.M{"_id": bson.M{"$in": users}}, opts)
+	usersCursor, err := collection.Find(usersCtx, filter, opts)
 	if err != nil {
 		return nil, err
 	}
 	defer usersCursor.Close(usersCtx)
-	userList := make([]*model.User, 0)
+	connection := &model.UserConnection{
+		Edges: make([]*model.UserEdge, 0),
+	}
+	hasNextPage := false
 	for usersCursor.Next(usersCtx) {
+		if len(connection.Edges) == size {
+			hasNextPage = true
+			break
+		}
 		var user UserSchema
 		err = usersCursor.Decode(&user)
-		userList = append(userList, &model.User{
-			Nickname:       user.Nickname,
-			Name:           user.Name,
-			Bio:            user.Bio,
-			Email:          user.Email,
-			Cover:          user.Cover,
-			Picture:        user.Picture,
-			Followers:      user.Followers,
-			FollowersCount: user.FollowersCount,
-			Following:      user.Following,
-			Lat:            user.Lat,
-			Lng:            user.Lng,
+		if err != nil {
+			return nil, err
+		}
+		connection.Edges = append(connection.Edges, &model.UserEdge{
+			Cursor: encodeCursor(strconv.Itoa(user.FollowersCount), user.Nickname),
+			Node:   newUserModel(&user, ""),
 		})
 	}
-	return userList, nil
+	connection.PageInfo = newPageInfo(c, hasNextPage, len(connection.Edges), func(i int) string {
+		return connection.Edges[i].Cursor
+	})
+	return connection, nil
 }
 
 func (db *tagsRepository) UpdateUserTags(user string, tags []string) (bool, error) {
@@ -224,6 +241,9 @@ func stringInSlice(a string, list []string) bool {
 // NewTagsRepository function
 func NewTagsRepository() TagsRepository {
 	client := newDatabaseClient()
+	client.Collection(CollectionUsers).Indexes().CreateOne(context.TODO(), mongo.IndexModel{
+		Keys: bson.D{{Key: "followerscount", Value: -1}, {Key: "_id", Value: -1}},
+	})
 	return &tagsRepository{
 		client,
 	}
@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Timeline settings
+const (
+	// FanOutThreshold is the follower count above which an author's posts are
+	// no longer copied into timelines and are read from the posts instead
+	FanOutThreshold = 1000
+	// TimelineBackfillSize is how many recent posts are copied into a timeline
+	// when its owner follows someone
+	TimelineBackfillSize = 100
+	fanOutBatchSize      = 500
+)
+
+// TimelineRepository interface
+type TimelineRepository interface {
+	FanOut(author *UserSchema, postID primitive.ObjectID, timestamp string) error
+	Backfill(owner string, author *UserSchema) error
+	Rebuild(owner string, following []string) error
+	Prune(owner, author string) error
+	RemovePost(postID primitive.ObjectID) error
+	GetEntries(owner string, after *pageCursor, limit int) ([]*TimelineEntry, error)
+}
+
+type timelineRepository struct {
+	client     *mongo.Database
+	collection *mongo.Collection
+}
+
+// TimelineEntry struct
+type TimelineEntry struct {
+	Owner     string             `bson:"owner"`
+	Post      primitive.ObjectID `bson:"post"`
+	Author    string             `bson:"author"`
+	Timestamp string             `bson:"timestamp"`
+}
+
+// IsFanOutOnRead reports whether the posts of author are too widely followed
+// to be copied into every follower's timeline
+func IsFanOutOnRead(author *UserSchema) bool {
+	return author.FollowersCount > FanOutThreshold
+}
+
+func (db *timelineRepository) FanOut(author *UserSchema, postID primitive.ObjectID, timestamp string) error {
+	if IsFanOutOnRead(author) {
+		return nil
+	}
+	entries := make([]interface{}, 0, fanOutBatchSize)
+	for _, follower := range author.Followers {
+		entries = append(entries, &TimelineEntry{
+			Owner:     follower,
+			Post:      postID,
+			Author:    author.Nickname,
+			Timestamp: timestamp,
+		})
+		if len(entries) == fanOutBatchSize {
+			if err := db.insert(entries); err != nil {
+				return err
+			}
+			entries = entries[:0]
+		}
+	}
+	return db.insert(entries)
+}
+
+func (db *timelineRepository) Backfill(owner string, author *UserSchema) error {
+	if IsFanOutOnRead(author) {
+		return nil
+	}
+	opts := options.Find().
+		SetLimit(TimelineBackfillSize).
+		SetSort(bson.D{{Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}).
+		SetProjection(bson.M{"_id": 1, "timestamp": 1})
+	ctx := context.TODO()
+	cursor, err := db.client.Collection(CollectionPosts).Find(ctx, bson.M{"author": author.Nickname}, opts)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	entries := make([]interface{}, 0)
+	for cursor.Next(ctx) {
+		var p struct {
+			ID        primitive.ObjectID `bson:"_id"`
+			Timestamp string             `bson:"timestamp"`
+		}
+		if err := cursor.Decode(&p); err != nil {
+			return err
+		}
+		entries = append(entries, &TimelineEntry{
+			Owner:     owner,
+			Post:      p.ID,
+			Author:    author.Nickname,
+			Timestamp: p.Timestamp,
+		})
+	}
+	return db.insert(entries)
+}
+
+// Rebuild backfills owner's timeline from every account in following, for
+// timelines that predate fan-out on write
+func (db *timelineRepository) Rebuild(owner string, following []string) error {
+	if len(following) == 0 {
+		return nil
+	}
+	ctx := context.TODO()
+	cursor, err := db.client.Collection(CollectionUsers).Find(ctx, bson.M{"_id": bson.M{"$in": following}},
+		options.Find().SetProjection(bson.M{"_id": 1, "followerscount": 1}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var author UserSchema
+		if err := cursor.Decode(&author); err != nil {
+			return err
+		}
+		if err := db.Backfill(owner, &author); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insert ignores entries that are already in their timeline
+func (db *timelineRepository) insert(entries []interface{}) error {
+	return insertIgnoringDuplicates(db.collection, entries)
+}
+
+// insertIgnoringDuplicates inserts entries, skipping those that collide
+// with a unique index
+func insertIgnoringDuplicates(collection *mongo.Collection, entries []interface{}) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	_, err := collection.InsertMany(context.TODO(), entries, options.InsertMany().SetOrdered(false))
+	if bulkErr, ok := err.(mongo.BulkWriteException); ok {
+		if bulkErr.WriteConcernError != nil {
+			return err
+		}
+		for _, writeErr := range bulkErr.WriteErrors {
+			if writeErr.Code != 11000 {
+				return err
+			}
+		}
+		return nil
+	}
+	return err
+}
+
+func (db *timelineRepository) Prune(owner, author string) error {
+	_, err := db.collection.DeleteMany(context.TODO(), bson.M{"owner": owner, "author": author})
+	return err
+}
+
+func (db *timelineRepository) RemovePost(postID primitive.ObjectID) error {
+	_, err := db.collection.DeleteMany(context.TODO(), bson.M{"post": postID})
+	return err
+}
+
+// GetEntries returns the newest entries of owner's timeline that come after
+// the cursor
+func (db *timelineRepository) GetEntries(owner string, after *pageCursor, limit int) ([]*TimelineEntry, error) {
+	filter := bson.M{"owner": owner}
+	if after != nil {
+		id, err := primitive.ObjectIDFromHex(after.ID)
+		if err != nil {
+			return nil, err
+		}
+		filter["$or"] = []bson.M{
+			{"timestamp": bson.M{"$lt": after.Key}},
+			{"timestamp": after.Key, "post": bson.M{"$lt": id}},
+		}
+	}
+	opts := options.Find().
+		SetLimit(int64(limit)).
+		SetSort(bson.D{{Key: "timestamp", Value: -1}, {Key: "post", Value: -1}})
+	ctx := context.TODO()
+	cursor, err := db.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	entries := make([]*TimelineEntry, 0)
+	for cursor.Next(ctx) {
+		var entry TimelineEntry
+		if err := cursor.Decode(&entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &entry)
+	}
+	return entries, nil
+}
+
+// NewTimelineRepository function
+func NewTimelineRepository() TimelineRepository {
+	client := newDatabaseClient()
+	collection := client.Collection(CollectionTimelines)
+	collection.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "owner", Value: 1}, {Key: "post", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "owner", Value: 1}, {Key: "timestamp", Value: -1}, {Key: "post", Value: -1}}},
+		{Keys: bson.D{{Key: "owner", Value: 1}, {Key: "author", Value: 1}}},
+		{Keys: bson.M{"post": 1}},
+	})
+	return &timelineRepository{
+		client,
+		collection,
+	}
+}
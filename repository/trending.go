@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Trending settings
+const (
+	// TrendingInterval is how often trending scores are recomputed
+	TrendingInterval = 10 * time.Minute
+	// TrendingWindow is how old a post can be and still trend
+	TrendingWindow = 7 * 24 * time.Hour
+	// TrendingGravity controls how fast scores decay with age
+	TrendingGravity = 1.8
+	// TrendingCommentWeight is how many likes a comment is worth
+	TrendingCommentWeight = 2.0
+	// TrendingBidBonus is added to posts that deliver an auction bid
+	TrendingBidBonus = 5.0
+	// EarthRadiusKm is used to turn region radii into radians
+	EarthRadiusKm = 6378.1
+)
+
+// GeoPoint is a GeoJSON point
+type GeoPoint struct {
+	Type        string    `bson:"type"`
+	Coordinates []float64 `bson:"coordinates"`
+}
+
+// NewGeoPoint function
+func NewGeoPoint(lat, lng float64) *GeoPoint {
+	return &GeoPoint{
+		Type:        "Point",
+		Coordinates: []float64{lng, lat},
+	}
+}
+
+// TrendingEntry struct
+type TrendingEntry struct {
+	Post       primitive.ObjectID `bson:"_id"`
+	Author     string             `bson:"author"`
+	Score      float64            `bson:"score"`
+	Tags       []string           `bson:"tags"`
+	Location   *GeoPoint          `bson:"location,omitempty"`
+	ComputedAt time.Time          `bson:"computedat"`
+}
+
+// TrendingScore ranks a post Hacker News style: its engagement divided by a
+// power of its age in hours, so new posts can overtake older popular ones
+func TrendingScore(likes, comments int, hasBid bool, age time.Duration) float64 {
+	points := float64(likes) + TrendingCommentWeight*float64(comments)
+	if hasBid {
+		points += TrendingBidBonus
+	}
+	hours := age.Hours()
+	if hours < 0 {
+		hours = 0
+	}
+	return points / math.Pow(hours+2, TrendingGravity)
+}
+
+// TrendingJob struct
+type TrendingJob struct {
+	client *mongo.Database
+	now    func() time.Time
+}
+
+// Start recomputes trending scores now and then every TrendingInterval
+func (j *TrendingJob) Start() {
+	go func() {
+		for {
+			j.Run()
+			time.Sleep(TrendingInterval)
+		}
+	}()
+}
+
+// Run recomputes the trending collection from the posts of the last
+// TrendingWindow
+func (j *TrendingJob) Run() error {
+	now := j.now()
+	since := strconv.FormatInt(now.Add(-TrendingWindow).Unix(), 10)
+	ctx := context.TODO()
+	cursor, err := j.client.Collection(CollectionPosts).Find(ctx, bson.M{"timestamp": bson.M{"$gte": since}},
+		options.Find().SetProjection(bson.M{
+			"author":         1,
+			"timestamp":      1,
+			"likecount":      1,
+			"comments.count": 1,
+			"bidid":          1,
+		}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	type trendingPost struct {
+		ID        primitive.ObjectID `bson:"_id"`
+		Author    string             `bson:"author"`
+		Timestamp string             `bson:"timestamp"`
+		LikeCount int                `bson:"likecount"`
+		Comments  struct {
+			Count int `bson:"count"`
+		} `bson:"comments"`
+		BidID *string `bson:"bidid"`
+	}
+	posts := make([]trendingPost, 0)
+	authors := make([]string, 0)
+	seen := make(map[string]bool)
+	for cursor.Next(ctx) {
+		var p trendingPost
+		if err := cursor.Decode(&p); err != nil {
+			return err
+		}
+		posts = append(posts, p)
+		if !seen[p.Author] {
+			seen[p.Author] = true
+			authors = append(authors, p.Author)
+		}
+	}
+	tags, locations, err := j.authorDetails(authors)
+	if err != nil {
+		return err
+	}
+	models := make([]mongo.WriteModel, 0, len(posts))
+	for _, p := range posts {
+		timestamp, err := strconv.ParseInt(p.Timestamp, 10, 64)
+		if err != nil {
+			continue
+		}
+		entry := &TrendingEntry{
+			Post:       p.ID,
+			Author:     p.Author,
+			Score:      TrendingScore(p.LikeCount, p.Comments.Count, p.BidID != nil, now.Sub(time.Unix(timestamp, 0))),
+			Tags:       tags[p.Author],
+			Location:   locations[p.Author],
+			ComputedAt: now,
+		}
+		if entry.Tags == nil {
+			entry.Tags = make([]string, 0)
+		}
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"_id": p.ID}).
+			SetReplacement(entry).
+			SetUpsert(true))
+	}
+	collection := j.client.Collection(CollectionTrending)
+	if len(models) > 0 {
+		_, err = collection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+		if err != nil {
+			return err
+		}
+	}
+	_, err = collection.DeleteMany(ctx, bson.M{"computedat": bson.M{"$lt": now}})
+	return err
+}
+
+// authorDetails returns the tags and location of every author
+func (j *TrendingJob) authorDetails(authors []string) (map[string][]string, map[string]*GeoPoint, error) {
+	tags := make(map[string][]string)
+	locations := make(map[string]*GeoPoint)
+	if len(authors) == 0 {
+		return tags, locations, nil
+	}
+	ctx := context.TODO()
+	cursor, err := j.client.Collection(CollectionTags).Find(ctx, bson.M{"users": bson.M{"$in": authors}})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var t TagSchema
+		if err := cursor.Decode(&t); err != nil {
+			return nil, nil, err
+		}
+		for _, u := range t.Users {
+			tags[u] = append(tags[u], t.ID)
+		}
+	}
+	users, err := j.client.Collection(CollectionUsers).Find(ctx, bson.M{"_id": bson.M{"$in": authors}},
+		options.Find().SetProjection(bson.M{"_id": 1, "publiclocation": 1}))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer users.Close(ctx)
+	for users.Next(ctx) {
+		var u UserSchema
+		if err := users.Decode(&u); err != nil {
+			return nil, nil, err
+		}
+		// regions are matched against what other users may see of the author
+		if lat, lng := u.PublicLocation(""); lat != nil {
+			locations[u.Nickname] = NewGeoPoint(*lat, *lng)
+		}
+	}
+	return tags, locations, nil
+}
+
+// NewTrendingJob function
+func NewTrendingJob() *TrendingJob {
+	client := newDatabaseClient()
+	client.Collection(CollectionTrending).Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "score", Value: -1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "tags", Value: 1}, {Key: "score", Value: -1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.M{"location": "2dsphere"}},
+		{Keys: bson.M{"computedat": 1}},
+	})
+	return &TrendingJob{
+		client: client,
+		now:    time.Now,
+	}
+}
@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"github.com/eaemenkkstudios/cancanvas-backend/service"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// TwoFactor struct
+type TwoFactor struct {
+	Enabled       bool     `json:"enabled"`
+	Secret        string   `json:"secret"`
+	PendingSecret string   `json:"pendingsecret"`
+	RecoveryCodes []string `json:"recoverycodes"`
+	LastCounter   int64    `json:"lastcounter"`
+}
+
+// RecoveryCodeCount is how many recovery codes are issued when 2FA is confirmed
+const RecoveryCodeCount = 10
+
+func newRecoveryCodes() (codes []string, hashes []string, err error) {
+	for i := 0; i < RecoveryCodeCount; i++ {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(base32.StdEncoding.EncodeToString(b))
+		codes = append(codes, code[:4]+"-"+code[4:])
+		hashes = append(hashes, hashToken(code))
+	}
+	return codes, hashes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(code), "-", "", -1))
+}
+
+func (db *authRespository) EnableTwoFactor(sender string) (*model.TwoFactorSetup, error) {
+	collection := db.client.Collection(CollectionUsers)
+	result := collection.FindOne(context.TODO(), bson.M{"_id": sender})
+	var u UserSchema
+	err := result.Decode(&u)
+	if err != nil {
+		return nil, errors.New("User not found")
+	}
+	if u.TwoFactor.Enabled {
+		return nil, errors.New("Two-factor authentication is already enabled")
+	}
+	secret, err := db.totp.GenerateSecret()
+	if err != nil {
+		return nil, errors.New("Could not enable two-factor authentication")
+	}
+	_, err = collection.UpdateOne(context.TODO(), bson.M{"_id": sender}, bson.M{
+		"$set": bson.M{"twofactor.pendingsecret": secret},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.TwoFactorSetup{
+		Secret: secret,
+		URI:    db.totp.ProvisioningURI(sender, secret),
+	}, nil
+}
+
+func (db *authRespository) ConfirmTwoFactor(sender, code string) ([]string, error) {
+	collection := db.client.Collection(CollectionUsers)
+	result := collection.FindOne(context.TODO(), bson.M{"_id": sender})
+	var u UserSchema
+	err := result.Decode(&u)
+	if err != nil {
+		return nil, errors.New("User not found")
+	}
+	if u.TwoFactor.Enabled {
+		return nil, errors.New("Two-factor authentication is already enabled")
+	}
+	if u.TwoFactor.PendingSecret == "" {
+		return nil, errors.New("Two-factor authentication was not requested")
+	}
+	accountKey := "2fa:account:" + sender
+	if err := db.throttle.Hit(accountKey, LoginAccountPolicy); err != nil {
+		return nil, err
+	}
+	counter, ok := db.totp.Validate(u.TwoFactor.PendingSecret, code, 0)
+	if !ok {
+		return nil, errors.New("Invalid code")
+	}
+	db.throttle.Reset(accountKey)
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, errors.New("Could not enable two-factor authentication")
+	}
+	_, err = collection.UpdateOne(context.TODO(), bson.M{"_id": sender}, bson.M{
+		"$set": bson.M{"twofactor": TwoFactor{
+			Enabled:       true,
+			Secret:        u.TwoFactor.PendingSecret,
+			RecoveryCodes: hashes,
+			LastCounter:   counter,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (db *authRespository) DisableTwoFactor(sender, code string) (bool, error) {
+	collection := db.client.Collection(CollectionUsers)
+	result := collection.FindOne(context.TODO(), bson.M{"_id": sender})
+	var u UserSchema
+	err := result.Decode(&u)
+	if err != nil {
+		return false, errors.New("User not found")
+	}
+	if !u.TwoFactor.Enabled {
+		return false, errors.New("Two-factor authentication is not enabled")
+	}
+	// a stolen session must not be able to guess its way to disabling 2FA
+	accountKey := "2fa:account:" + sender
+	if err := db.throttle.Hit(accountKey, LoginAccountPolicy); err != nil {
+		return false, err
+	}
+	if !db.checkSecondFactor(&u, code) {
+		return false, errors.New("Invalid code")
+	}
+	db.throttle.Reset(accountKey)
+	_, err = collection.UpdateOne(context.TODO(), bson.M{"_id": sender}, bson.M{
+		"$set": bson.M{"twofactor": TwoFactor{}},
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (db *authRespository) VerifyTwoFactor(ctx context.Context, challenge, code string) (*model.Login, error) {
+	claims, err := service.NewJWTService().GetClaimsFromToken(challenge)
+	if err != nil || claims["purpose"] != service.TwoFactorPurpose {
+		return nil, errors.New("Invalid or expired challenge")
+	}
+	nickname := fmt.Sprintf("%v", claims["name"])
+	accountKey := "2fa:account:" + nickname
+	ipKey := clientKey(ctx, "login:ip:")
+	if err := db.hitLogin(accountKey, ipKey); err != nil {
+		return nil, err
+	}
+	collection := db.client.Collection(CollectionUsers)
+	result := collection.FindOne(context.TODO(), bson.M{"_id": nickname})
+	var u UserSchema
+	err = result.Decode(&u)
+	if err != nil || !u.TwoFactor.Enabled {
+		return nil, errors.New("Unauthorized")
+	}
+	if !db.checkSecondFactor(&u, code) {
+		return nil, errors.New("Invalid code")
+	}
+	db.loginSucceeded(accountKey, ipKey)
+	return db.completeLogin(&u)
+}
+
+// checkSecondFactor accepts either a TOTP code or an unused recovery code,
+// consuming whichever one matched so it can't be used twice.
+func (db *authRespository) checkSecondFactor(u *UserSchema, code string) bool {
+	collection := db.client.Collection(CollectionUsers)
+	if counter, ok := db.totp.Validate(u.TwoFactor.Secret, strings.TrimSpace(code), u.TwoFactor.LastCounter); ok {
+		result, err := collection.UpdateOne(context.TODO(), bson.M{
+			"_id":                   u.Nickname,
+			"twofactor.lastcounter": bson.M{"$lt": counter},
+		}, bson.M{
+			"$set": bson.M{"twofactor.lastcounter": counter},
+		})
+		return err == nil && result.ModifiedCount > 0
+	}
+	hash := hashToken(normalizeRecoveryCode(code))
+	result, err := collection.UpdateOne(context.TODO(), bson.M{
+		"_id":                     u.Nickname,
+		"twofactor.recoverycodes": hash,
+	}, bson.M{
+		"$pull": bson.M{"twofactor.recoverycodes": hash},
+	})
+	return err == nil && result.ModifiedCount > 0
+}
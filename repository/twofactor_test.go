@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"testing"
+)
+
+// stubTOTPService accepts a single code
+type stubTOTPService struct {
+	code string
+}
+
+func (s stubTOTPService) GenerateSecret() (string, error) {
+	return "SECRET", nil
+}
+
+func (s stubTOTPService) ProvisioningURI(account, secret string) string {
+	return "otpauth://totp/" + account
+}
+
+func (s stubTOTPService) Validate(secret, code string, lastCounter int64) (int64, bool) {
+	return lastCounter + 1, code == s.code
+}
+
+func newTestTwoFactorRepository(t *testing.T, twoFactor TwoFactor) *authRespository {
+	db := newTestAuthRepository(t, nil)
+	db.totp = stubTOTPService{"123456"}
+	if _, err := db.client.Collection(CollectionUsers).InsertOne(context.TODO(), &UserSchema{Nickname: "user", TwoFactor: twoFactor}); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestConfirmTwoFactorThrottle(t *testing.T) {
+	db := newTestTwoFactorRepository(t, TwoFactor{PendingSecret: "SECRET"})
+	for i := 0; i < LoginAccountPolicy.MaxAttempts; i++ {
+		if _, err := db.ConfirmTwoFactor("user", "000000"); err == nil || err.Error() != "Invalid code" {
+			t.Fatalf("attempt %d: %v", i+1, err)
+		}
+	}
+	if _, err := db.ConfirmTwoFactor("user", "123456"); err == nil {
+		t.Fatal("two-factor authentication was confirmed while locked out")
+	}
+	if findTestUser(t, db, "user").TwoFactor.Enabled {
+		t.Error("two-factor authentication is enabled")
+	}
+}
+
+func TestDisableTwoFactorThrottle(t *testing.T) {
+	db := newTestTwoFactorRepository(t, TwoFactor{Enabled: true, Secret: "SECRET"})
+	for i := 0; i < LoginAccountPolicy.MaxAttempts; i++ {
+		if _, err := db.DisableTwoFactor("user", "000000"); err == nil || err.Error() != "Invalid code" {
+			t.Fatalf("attempt %d: %v", i+1, err)
+		}
+	}
+	if ok, err := db.DisableTwoFactor("user", "123456"); ok || err == nil {
+		t.Fatal("two-factor authentication was disabled while locked out")
+	}
+	if !findTestUser(t, db, "user").TwoFactor.Enabled {
+		t.Error("two-factor authentication is disabled")
+	}
+}
+
+func TestDisableTwoFactorResetsThrottle(t *testing.T) {
+	db := newTestTwoFactorRepository(t, TwoFactor{Enabled: true, Secret: "SECRET"})
+	for i := 0; i < LoginAccountPolicy.MaxAttempts-1; i++ {
+		db.DisableTwoFactor("user", "000000")
+	}
+	if ok, err := db.DisableTwoFactor("user", "123456"); !ok || err != nil {
+		t.Fatalf("disable failed: %v", err)
+	}
+	if attempt, _ := db.throttle.store.Get("2fa:account:user"); attempt != nil {
+		t.Errorf("attempts weren't reset: %+v", attempt)
+	}
+}
@@ -3,6 +3,9 @@ package repository
 import (
 	"context"
 	"errors"
+	"net/mail"
+	"os"
+	"regexp"
 	"strings"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -16,8 +19,8 @@ import (
 // UserRepository Interface
 type UserRepository interface {
 	CreateUser(user *model.NewUser) (*model.User, error)
-	FindOne(nickname string) (*model.User, error)
-	FindAll(nickname *string, page *int) ([]*model.User, error)
+	FindOne(nickname, viewer string) (*model.User, error)
+	FindAll(nickname *string, first *int, after *string) (*model.UserConnection, error)
 	Follow(sender, target string) (bool, error)
 	Unfollow(sender, target string) (bool, error)
 	IsFollowing(sender, target string) bool
@@ -25,35 +28,99 @@ type UserRepository interface {
 	UpdateCover(sender string, cover graphql.Upload) (string, error)
 	UpdateLocation(sender string, lat, lng float64) (bool, error)
 	UpdateBio(sender, bio string) (bool, error)
+	UpdateRole(nickname string, role model.Role) (bool, error)
+	VerifyEmail(nickname, email string) (bool, error)
+	ResendVerificationEmail(sender string) (bool, error)
+	ChangeEmail(sender, email string) (bool, error)
+	UpdateLocale(sender, locale string) (bool, error)
+	Block(sender, target string) (bool, error)
+	Unblock(sender, target string) (bool, error)
+	SuggestUsers(sender string, first *int) ([]*model.UserSuggestion, error)
+	UpdateLocationPrivacy(sender string, privacy model.LocationPrivacy) (bool, error)
+	FindNear(sender string, lat, lng, radiusKm float64, tags []string, first *int) ([]*model.NearbyUser, error)
 }
 
 type userRepository struct {
 	client     *mongo.Database
 	awsSession service.AwsService
+	mailer     service.MailerService
+	timelines  TimelineRepository
+	sessions   SessionRepository
 	collection *mongo.Collection
+	hasher     service.PasswordHasher
 }
 
 // UserSchema struct
 type UserSchema struct {
-	Nickname       string     `json:"nickname" bson:"_id"`
-	Name           string     `json:"name"`
-	Email          string     `json:"email"`
-	Picture        string     `json:"picture"`
-	Cover          string     `json:"cover"`
-	Bio            string     `json:"bio"`
-	Followers      []string   `json:"followers"`
-	FollowersCount int        `json:"followerscount"`
-	Following      []string   `json:"following"`
-	Password       Password   `json:"password"`
-	Chats          []userChat `json:"chats"`
-	First          bool       `json:"first"`
-	Lat            float64    `json:"lat"`
-	Lng            float64    `json:"lng"`
+	Nickname        string                `json:"nickname" bson:"_id"`
+	Name            string                `json:"name"`
+	Email           string                `json:"email"`
+	EmailVerified   bool                  `json:"emailverified"`
+	Locale          string                `json:"locale"`
+	Picture         string                `json:"picture"`
+	Cover           string                `json:"cover"`
+	Bio             string                `json:"bio"`
+	Followers       []string              `json:"followers"`
+	FollowersCount  int                   `json:"followerscount"`
+	Following       []string              `json:"following"`
+	Password        Password              `json:"password"`
+	Chats           []userChat            `json:"chats"`
+	First           bool                  `json:"first"`
+	Location        *GeoPoint             `json:"location" bson:"location,omitempty"`
+	SharedLocation  *GeoPoint             `json:"publiclocation" bson:"publiclocation,omitempty"`
+	LocationPrivacy model.LocationPrivacy `json:"locationprivacy"`
+	Role            model.Role            `json:"role"`
+	TwoFactor       TwoFactor             `json:"twofactor"`
+	Identities      []Identity            `json:"identities"`
+	Blocked         []string              `json:"blocked"`
+}
+
+// Identity struct
+type Identity struct {
+	Provider string `json:"provider"`
+	Subject  string `json:"subject"`
+}
+
+// newUserModel converts u for viewer, coarsening or hiding the location
+// according to u's privacy setting
+func newUserModel(u *UserSchema, viewer string) *model.User {
+	lat, lng := u.PublicLocation(viewer)
+	return &model.User{
+		Nickname:        u.Nickname,
+		Name:            u.Name,
+		Email:           u.Email,
+		EmailVerified:   u.EmailVerified,
+		Followers:       u.Followers,
+		FollowersCount:  u.FollowersCount,
+		Following:       u.Following,
+		Picture:         u.Picture,
+		Cover:           u.Cover,
+		Bio:             u.Bio,
+		Lat:             lat,
+		Lng:             lng,
+		LocationPrivacy: u.GetLocationPrivacy(),
+	}
 }
 
 func (db *userRepository) CreateUser(user *model.NewUser) (*model.User, error) {
+	if _, err := mail.ParseAddress(user.Email); err != nil {
+		return nil, errors.New("Invalid email address")
+	}
+	password, err := GeneratePassword(db.hasher, user.Password)
+	if err != nil {
+		return nil, errors.New("Could not create user")
+	}
+	locale := service.DefaultLocale
+	if user.Locale != nil {
+		if !service.IsSupportedLocale(*user.Locale) {
+			return nil, errors.New("Unsupported locale")
+		}
+		locale = service.NormalizeLocale(*user.Locale)
+	}
 	u := &UserSchema{
 		Email:          user.Email,
+		EmailVerified:  false,
+		Locale:         locale,
 		Nickname:       strings.ToLower(user.Nickname),
 		Name:           user.Name,
 		Following:      make([]string, 0),
@@ -61,87 +128,78 @@ func (db *userRepository) CreateUser(user *model.NewUser) (*model.User, error) {
 		Followers:      make([]string, 0),
 		Chats:          make([]userChat, 0),
 		First:          true,
+		Role:           model.RoleUser,
 		Picture:        "",
 		Cover:          "",
 		Bio:            "",
-		Lat:            0,
-		Lng:            0,
-		Password:       GeneratePassword(user.Password),
+		Password:       password,
 	}
-	_, err := db.collection.InsertOne(context.TODO(), u)
+	_, err = db.collection.InsertOne(context.TODO(), u)
 	if err != nil {
 		return nil, errors.New("User '" + user.Nickname + "' already exists")
 	}
-	return &model.User{
-		Email:          user.Email,
-		Name:           user.Name,
-		Nickname:       user.Nickname,
-		Followers:      u.Followers,
-		FollowersCount: u.FollowersCount,
-		Following:      u.Following,
-		Picture:        u.Picture,
-		Cover:          u.Cover,
-		Bio:            u.Bio,
-		Lat:            u.Lat,
-		Lng:            u.Lng,
-	}, nil
+	db.sendVerificationEmail(u)
+	return newUserModel(u, u.Nickname), nil
 }
 
-func (db *userRepository) FindOne(nickname string) (*model.User, error) {
+func (db *userRepository) FindOne(nickname, viewer string) (*model.User, error) {
 	result := db.collection.FindOne(context.TODO(), bson.M{"_id": nickname})
 	var user *UserSchema
 	err := result.Decode(&user)
 	if err != nil {
 		return nil, errors.New("User not found")
 	}
-	return &model.User{
-		Email:          user.Email,
-		Name:           user.Name,
-		Nickname:       user.Nickname,
-		Followers:      user.Followers,
-		FollowersCount: user.FollowersCount,
-		Following:      user.Following,
-		Picture:        user.Picture,
-		Cover:          user.Cover,
-		Bio:            user.Bio,
-		Lat:            user.Lat,
-		Lng:            user.Lng,
-	}, nil
+	return newUserModel(user, viewer), nil
 }
 
-func (db *userRepository) FindAll(nickname *string, page *int) ([]*model.User, error) {
-	if page == nil || *page < 1 {
-		*page = 1
+func (db *userRepository) FindAll(nickname *string, first *int, after *string) (*model.UserConnection, error) {
+	size, err := pageSize(first)
+	if err != nil {
+		return nil, err
 	}
-	document := bson.M{}
+	c, err := decodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{}
 	if nickname != nil && *nickname != "" {
-		document = bson.M{"_id": bson.M{"$regex": nickname}}
+		filter["_id"] = bson.M{"$regex": regexp.QuoteMeta(*nickname)}
+	}
+	if c != nil {
+		filter = bson.M{"$and": []bson.M{filter, {"_id": bson.M{"$gt": c.ID}}}}
 	}
 	opts := options.Find().
-		SetSkip(int64(PageSize * (*page - 1))).
-		SetLimit(PageSize)
+		SetLimit(int64(size + 1)).
+		SetSort(bson.M{"_id": 1})
 	ctx := context.TODO()
-	cursor, err := db.collection.Find(ctx, document, opts)
+	cursor, err := db.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, errors.New("Could not load users")
+	}
 	defer cursor.Close(ctx)
-	var users []*model.User
+	connection := &model.UserConnection{
+		Edges: make([]*model.UserEdge, 0),
+	}
+	hasNextPage := false
 	for cursor.Next(ctx) {
+		if len(connection.Edges) == size {
+			hasNextPage = true
+			break
+		}
 		var u *UserSchema
 		err = cursor.Decode(&u)
-		users = append(users, &model.User{
-			Nickname:       u.Nickname,
-			Name:           u.Name,
-			Email:          u.Email,
-			Followers:      u.Followers,
-			FollowersCount: u.FollowersCount,
-			Following:      u.Following,
-			Picture:        u.Picture,
-			Cover:          u.Cover,
-			Bio:            u.Bio,
-			Lat:            u.Lat,
-			Lng:            u.Lng,
+		if err != nil {
+			return nil, err
+		}
+		connection.Edges = append(connection.Edges, &model.UserEdge{
+			Cursor: encodeCursor("", u.Nickname),
+			Node:   newUserModel(u, ""),
 		})
 	}
-	return users, err
+	connection.PageInfo = newPageInfo(c, hasNextPage, len(connection.Edges), func(i int) string {
+		return connection.Edges[i].Cursor
+	})
+	return connection, nil
 }
 
 func (db *userRepository) Follow(sender, target string) (bool, error) {
@@ -188,6 +246,7 @@ func (db *userRepository) Follow(sender, target string) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	db.timelines.Backfill(sender, targetUser)
 	return true, nil
 }
 
@@ -240,6 +299,7 @@ func (db *userRepository) Unfollow(sender, target string) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	db.timelines.Prune(sender, target)
 
 	return true, nil
 }
@@ -292,8 +352,12 @@ func (db *userRepository) UpdateProfilePicture(sender string, picture graphql.Up
 }
 
 func (db *userRepository) UpdateLocation(sender string, lat, lng float64) (bool, error) {
-	_, err := db.collection.UpdateOne(context.TODO(), bson.M{"_id": sender}, bson.M{
-		"$set": bson.M{"lat": lat, "lng": lng},
+	if !validCoordinates(lat, lng) {
+		return false, errors.New("Invalid coordinates")
+	}
+	_, err := db.collection.UpdateOne(context.TODO(), bson.M{"_id": sender}, []bson.M{
+		{"$set": bson.M{"location": bson.M{"$literal": NewGeoPoint(lat, lng)}}},
+		publicLocationStage(),
 	})
 	if err != nil {
 		return false, err
@@ -311,6 +375,192 @@ func (db *userRepository) UpdateBio(sender, bio string) (bool, error) {
 	return true, nil
 }
 
+func (db *userRepository) UpdateRole(nickname string, role model.Role) (bool, error) {
+	if !role.IsValid() {
+		return false, errors.New("Invalid role")
+	}
+	result, err := db.collection.UpdateOne(context.TODO(), bson.M{"_id": nickname}, bson.M{
+		"$set": bson.M{"role": role},
+	})
+	if err != nil {
+		return false, err
+	}
+	if result.MatchedCount == 0 {
+		return false, errors.New("User not found")
+	}
+	// the role is part of the access tokens, so the user signs in again to
+	// get tokens with the new one
+	if result.ModifiedCount > 0 {
+		return db.sessions.RevokeAllSessions(nickname)
+	}
+	return true, nil
+}
+
+func (db *userRepository) VerifyEmail(nickname, email string) (bool, error) {
+	result, err := db.collection.UpdateOne(context.TODO(), bson.M{"_id": nickname, "email": email}, bson.M{
+		"$set": bson.M{"emailverified": true},
+	})
+	if err != nil || result.MatchedCount == 0 {
+		return false, errors.New("Invalid or expired token")
+	}
+	return true, nil
+}
+
+// migrateEmailVerification treats the accounts created before emails were
+// verified as verified, so they keep password reset and auctions
+func migrateEmailVerification(collection *mongo.Collection) error {
+	_, err := collection.UpdateMany(context.TODO(), bson.M{"emailverified": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"emailverified": true}})
+	return err
+}
+
+func (db *userRepository) ResendVerificationEmail(sender string) (bool, error) {
+	result := db.collection.FindOne(context.TODO(), bson.M{"_id": sender})
+	var user UserSchema
+	err := result.Decode(&user)
+	if err != nil {
+		return false, errors.New("User not found")
+	}
+	if user.EmailVerified {
+		return false, errors.New("Your email is already verified")
+	}
+	return db.sendVerificationEmail(&user)
+}
+
+func (db *userRepository) ChangeEmail(sender, email string) (bool, error) {
+	if _, err := mail.ParseAddress(email); err != nil {
+		return false, errors.New("Invalid email address")
+	}
+	result := db.collection.FindOneAndUpdate(context.TODO(), bson.M{"_id": sender}, bson.M{
+		"$set": bson.M{"email": email, "emailverified": false},
+	})
+	var previous UserSchema
+	err := result.Decode(&previous)
+	if err != nil {
+		return false, errors.New("User not found")
+	}
+	if previous.EmailVerified && previous.Email != email {
+		db.sendEmailChangedEmail(&previous, email)
+	}
+	user := previous
+	user.Email = email
+	user.EmailVerified = false
+	return db.sendVerificationEmail(&user)
+}
+
+// sendEmailChangedEmail tells the previous address of user about the change,
+// with a password reset link in case the account was taken over
+func (db *userRepository) sendEmailChangedEmail(user *UserSchema, email string) error {
+	token, err := newPasswordReset(db.client, user.Nickname)
+	if err != nil {
+		return err
+	}
+	return db.mailer.Send(&service.Mail{
+		To:       user.Email,
+		Locale:   user.Locale,
+		Template: service.MailEmailChanged,
+		Data: map[string]string{
+			"Name":     user.Name,
+			"Email":    user.Email,
+			"NewEmail": email,
+			"Link":     os.Getenv("SERVER_URL") + "/resetpassword?token=" + token,
+		},
+	})
+}
+
+func (db *userRepository) UpdateLocale(sender, locale string) (bool, error) {
+	if !service.IsSupportedLocale(locale) {
+		return false, errors.New("Unsupported locale")
+	}
+	result, err := db.collection.UpdateOne(context.TODO(), bson.M{"_id": sender}, bson.M{
+		"$set": bson.M{"locale": service.NormalizeLocale(locale)},
+	})
+	if err != nil {
+		return false, err
+	}
+	if result.MatchedCount == 0 {
+		return false, errors.New("User not found")
+	}
+	return true, nil
+}
+
+// Block hides target from sender's recommendations and ends any follow
+// between the two
+func (db *userRepository) Block(sender, target string) (bool, error) {
+	if sender == target {
+		return false, errors.New("You can't block yourself")
+	}
+	count, err := db.collection.CountDocuments(context.TODO(), bson.M{"_id": target})
+	if err != nil || count == 0 {
+		return false, errors.New("No user named '" + target + "' found")
+	}
+	_, err = db.collection.UpdateOne(context.TODO(), bson.M{"_id": sender}, bson.M{
+		"$addToSet": bson.M{"blocked": target},
+	})
+	if err != nil {
+		return false, err
+	}
+	db.Unfollow(sender, target)
+	db.Unfollow(target, sender)
+	return true, nil
+}
+
+func (db *userRepository) Unblock(sender, target string) (bool, error) {
+	result, err := db.collection.UpdateOne(context.TODO(), bson.M{"_id": sender, "blocked": target}, bson.M{
+		"$pull": bson.M{"blocked": target},
+	})
+	if err != nil {
+		return false, err
+	}
+	if result.ModifiedCount == 0 {
+		return false, errors.New("You haven't blocked '" + target + "'")
+	}
+	return true, nil
+}
+
+// blockedUsers returns the accounts u blocked or was blocked by
+func blockedUsers(client *mongo.Database, u *UserSchema) (map[string]bool, error) {
+	blocked := make(map[string]bool)
+	for _, b := range u.Blocked {
+		blocked[b] = true
+	}
+	ctx := context.TODO()
+	cursor, err := client.Collection(CollectionUsers).Find(ctx, bson.M{"blocked": u.Nickname},
+		options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var b struct {
+			Nickname string `bson:"_id"`
+		}
+		if err := cursor.Decode(&b); err != nil {
+			return nil, err
+		}
+		blocked[b.Nickname] = true
+	}
+	return blocked, nil
+}
+
+func (db *userRepository) sendVerificationEmail(user *UserSchema) (bool, error) {
+	token := service.NewJWTService().GenerateVerifyEmailToken(user.Nickname, user.Email)
+	err := db.mailer.Send(&service.Mail{
+		To:       user.Email,
+		Locale:   user.Locale,
+		Template: service.MailVerifyEmail,
+		Data: map[string]string{
+			"Name":  user.Name,
+			"Email": user.Email,
+			"Link":  os.Getenv("SERVER_URL") + "/verifyemail?token=" + token,
+		},
+	})
+	if err != nil {
+		return false, errors.New("Could not send email")
+	}
+	return true, nil
+}
+
 func (db *userRepository) UpdateCover(sender string, cover graphql.Upload) (string, error) {
 	result := db.collection.FindOne(context.TODO(), bson.M{"_id": sender})
 	var user UserSchema
@@ -342,9 +592,22 @@ func (db *userRepository) UpdateCover(sender string, cover graphql.Upload) (stri
 func NewUserRepository() UserRepository {
 	client := newDatabaseClient()
 	awsSession := service.NewAwsService()
+	mailer := NewMailOutbox()
+	collection := client.Collection(CollectionUsers)
+	collection.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.M{"blocked": 1}},
+		{Keys: bson.M{"location": "2dsphere"}},
+		{Keys: bson.M{"publiclocation": "2dsphere"}},
+	})
+	migrateUserLocations(collection)
+	migrateEmailVerification(collection)
 	return &userRepository{
 		client:     client,
 		awsSession: awsSession,
-		collection: client.Collection(CollectionUsers),
+		mailer:     mailer,
+		timelines:  NewTimelineRepository(),
+		sessions:   NewSessionRepository(),
+		collection: collection,
+		hasher:     service.NewPasswordHasher(),
 	}
 }
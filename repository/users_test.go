@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/eaemenkkstudios/cancanvas-backend/graph/model"
+	"github.com/eaemenkkstudios/cancanvas-backend/service"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func newTestUserRepository(t *testing.T) *userRepository {
+	if os.Getenv("JWT_SECRET") == "" {
+		os.Setenv("JWT_SECRET", "secret")
+		t.Cleanup(func() { os.Unsetenv("JWT_SECRET") })
+	}
+	client := testDatabase(t)
+	return &userRepository{
+		client: client,
+		sessions: &sessionRepository{
+			client:     client,
+			collection: client.Collection(CollectionSessions),
+			jwtService: service.NewJWTService(),
+		},
+		collection: client.Collection(CollectionUsers),
+	}
+}
+
+func activeTestSessions(t *testing.T, db *userRepository, user string) int64 {
+	count, err := db.client.Collection(CollectionSessions).CountDocuments(context.TODO(), bson.M{"user": user, "revoked": false})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return count
+}
+
+// TestUpdateRoleRevokesSessions checks that a demoted user can't keep using
+// tokens issued with their old role
+func TestUpdateRoleRevokesSessions(t *testing.T) {
+	db := newTestUserRepository(t)
+	if _, err := db.collection.InsertOne(context.TODO(), &UserSchema{Nickname: "user", Role: model.RoleAdmin}); err != nil {
+		t.Fatal(err)
+	}
+	login, err := db.sessions.CreateSession("user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := db.UpdateRole("user", model.RoleAdmin); !ok || err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if activeTestSessions(t, db, "user") != 1 {
+		t.Fatal("sessions were revoked although the role didn't change")
+	}
+	if ok, err := db.UpdateRole("user", model.RoleUser); !ok || err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if count := activeTestSessions(t, db, "user"); count != 0 {
+		t.Errorf("%d sessions are still active", count)
+	}
+	if _, err := db.sessions.RefreshSession(*login.RefreshToken); err == nil {
+		t.Error("session was refreshed after the role changed")
+	}
+}
+
+func TestChangeEmailNotifiesPreviousAddress(t *testing.T) {
+	db := newTestUserRepository(t)
+	transport := service.NewMemoryTransport()
+	db.mailer = service.NewMailerServiceWithTransport("noreply@example.com", transport)
+	_, err := db.collection.InsertOne(context.TODO(), &UserSchema{Nickname: "user", Email: "old@example.com", EmailVerified: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := db.ChangeEmail("user", "new@example.com"); !ok || err != nil {
+		t.Fatalf("change failed: %v", err)
+	}
+	recipients := make(map[string]bool)
+	for _, m := range transport.Messages() {
+		recipients[m.To[0]] = true
+	}
+	if !recipients["old@example.com"] || !recipients["new@example.com"] {
+		t.Errorf("mail was sent to %v, want the old and the new address", recipients)
+	}
+	count, err := db.client.Collection(CollectionPasswordResets).CountDocuments(context.TODO(), bson.M{"user": "user"})
+	if err != nil || count != 1 {
+		t.Errorf("%d password resets were created, want 1", count)
+	}
+	if user := findTestUserSchema(t, db, "user"); user.Email != "new@example.com" || user.EmailVerified {
+		t.Errorf("email = %s, verified %v", user.Email, user.EmailVerified)
+	}
+
+	// an address that was never verified isn't told, since it may not be
+	// the owner's
+	sent := len(transport.Messages())
+	if ok, err := db.ChangeEmail("user", "other@example.com"); !ok || err != nil {
+		t.Fatalf("change failed: %v", err)
+	}
+	for _, m := range transport.Messages()[sent:] {
+		if m.To[0] == "new@example.com" {
+			t.Error("unverified address was told about the change")
+		}
+	}
+}
+
+func findTestUserSchema(t *testing.T, db *userRepository, nickname string) *UserSchema {
+	var user UserSchema
+	if err := db.collection.FindOne(context.TODO(), bson.M{"_id": nickname}).Decode(&user); err != nil {
+		t.Fatalf("user %s: %v", nickname, err)
+	}
+	return &user
+}
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"log"
 	"os"
 
 	"github.com/eaemenkkstudios/cancanvas-backend/middleware"
+	"github.com/eaemenkkstudios/cancanvas-backend/repository"
 	"github.com/gin-gonic/gin"
 	_ "github.com/joho/godotenv/autoload"
 )
@@ -24,6 +26,15 @@ func main() {
 	server.GET("/cancel", middleware.CancelHandler())
 	server.GET("/return", middleware.ResultHandler())
 	server.GET("/resetpassword", middleware.ResetPasswordHandler())
+	server.GET("/verifyemail", middleware.VerifyEmailHandler())
+
+	mailWorker, err := repository.NewMailWorker()
+	if err != nil {
+		log.Fatal(err)
+	}
+	mailWorker.Start()
+	repository.NewTrendingJob().Start()
+	repository.NewAuctionJob().Start()
 
 	server.Run(":" + port)
 }
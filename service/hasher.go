@@ -0,0 +1,147 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Password hashing algorithms
+const (
+	AlgorithmArgon2id = "argon2id"
+	AlgorithmBcrypt   = "bcrypt"
+)
+
+// PasswordHasher interface
+type PasswordHasher interface {
+	Algorithm() string
+	Hash(password string) (string, error)
+	Compare(hash, password string) bool
+}
+
+// MaxConcurrentArgon2id caps how many argon2id hashes run at once. Each one
+// allocates its whole memory setting, so a burst of logins would otherwise
+// take as much memory as it has requests.
+const MaxConcurrentArgon2id = 4
+
+var argon2idSlots = make(chan struct{}, MaxConcurrentArgon2id)
+
+func argon2idKey(password, salt []byte, time, memory uint32, threads uint8, keyLen uint32) []byte {
+	argon2idSlots <- struct{}{}
+	defer func() { <-argon2idSlots }()
+	return argon2.IDKey(password, salt, time, memory, threads, keyLen)
+}
+
+type argon2idHasher struct {
+	time    uint32
+	memory  uint32
+	threads uint8
+	keyLen  uint32
+	saltLen int
+}
+
+func (h *argon2idHasher) Algorithm() string {
+	return AlgorithmArgon2id
+}
+
+func (h *argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2idKey([]byte(password), salt, h.time, h.memory, h.threads, h.keyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		h.memory,
+		h.time,
+		h.threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (h *argon2idHasher) Compare(hash, password string) bool {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != AlgorithmArgon2id {
+		return false
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false
+	}
+	var memory, time uint32
+	var threads uint8
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil {
+		return false
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return false
+	}
+	other := argon2idKey([]byte(password), salt, time, memory, threads, uint32(len(key)))
+	return subtle.ConstantTimeCompare(key, other) == 1
+}
+
+type bcryptHasher struct {
+	cost int
+}
+
+func (h *bcryptHasher) Algorithm() string {
+	return AlgorithmBcrypt
+}
+
+func (h *bcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+func (h *bcryptHasher) Compare(hash, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// GetPasswordHasher function
+func GetPasswordHasher(algorithm string) (PasswordHasher, error) {
+	switch algorithm {
+	case AlgorithmArgon2id:
+		return &argon2idHasher{
+			time:    1,
+			memory:  64 * 1024,
+			threads: 4,
+			keyLen:  32,
+			saltLen: 16,
+		}, nil
+	case AlgorithmBcrypt:
+		return &bcryptHasher{
+			cost: bcrypt.DefaultCost,
+		}, nil
+	}
+	return nil, errors.New("Unknown password hashing algorithm '" + algorithm + "'")
+}
+
+// NewPasswordHasher function
+func NewPasswordHasher() PasswordHasher {
+	algorithm := os.Getenv("PASSWORD_HASHER")
+	if algorithm == "" {
+		algorithm = AlgorithmArgon2id
+	}
+	hasher, err := GetPasswordHasher(algorithm)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return hasher
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestPasswordHashers(t *testing.T) {
+	for _, algorithm := range []string{AlgorithmArgon2id, AlgorithmBcrypt} {
+		t.Run(algorithm, func(t *testing.T) {
+			hasher, err := GetPasswordHasher(algorithm)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hasher.Algorithm() != algorithm {
+				t.Errorf("Algorithm() = %q", hasher.Algorithm())
+			}
+			hash, err := hasher.Hash("correct horse")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Contains(hash, "correct horse") {
+				t.Fatal("hash contains the password")
+			}
+			other, err := hasher.Hash("correct horse")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hash == other {
+				t.Error("hashes of the same password are equal, the salt isn't random")
+			}
+			if !hasher.Compare(hash, "correct horse") {
+				t.Error("password doesn't match its hash")
+			}
+			for _, wrong := range []string{"", "correct horse ", "Correct horse", "battery staple"} {
+				if hasher.Compare(hash, wrong) {
+					t.Errorf("%q matches the hash", wrong)
+				}
+			}
+			for _, malformed := range []string{"", "$argon2id$", hash[:len(hash)/2], "$2a$10$" + strings.Repeat("a", 53)} {
+				if hasher.Compare(malformed, "correct horse") {
+					t.Errorf("malformed hash %q matches", malformed)
+				}
+			}
+		})
+	}
+}
+
+func TestArgon2idHashFormat(t *testing.T) {
+	hasher, _ := GetPasswordHasher(AlgorithmArgon2id)
+	hash, err := hasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(hash, "$argon2id$v=19$m=65536,t=1,p=4$") {
+		t.Errorf("hash = %q", hash)
+	}
+	// the parameters are read from the hash, so older settings keep working
+	weaker := &argon2idHasher{time: 1, memory: 8 * 1024, threads: 1, keyLen: 16, saltLen: 8}
+	old, err := weaker.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !hasher.Compare(old, "password") {
+		t.Error("hash with other parameters doesn't match")
+	}
+}
+
+func TestArgon2idConcurrently(t *testing.T) {
+	hasher, _ := GetPasswordHasher(AlgorithmArgon2id)
+	hash, err := hasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 3*MaxConcurrentArgon2id; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !hasher.Compare(hash, "password") {
+				t.Error("password doesn't match its hash")
+			}
+		}()
+	}
+	wg.Wait()
+	if len(argon2idSlots) != 0 {
+		t.Errorf("%d slots are still taken", len(argon2idSlots))
+	}
+}
+
+func TestGetPasswordHasherUnknown(t *testing.T) {
+	if _, err := GetPasswordHasher("md5"); err == nil {
+		t.Error("unknown algorithm was accepted")
+	}
+}
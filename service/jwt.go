@@ -13,24 +13,49 @@ import (
 
 // JWTService interface
 type JWTService interface {
-	GenerateToken(name string, admin bool) string
-	GenerateResetPasswordToken(name, hash string) string
+	GenerateToken(name, sessionID, role string) string
+	GenerateVerifyEmailToken(name, email string) string
+	GenerateTwoFactorToken(name string) string
+	GenerateProviderSignupToken(identity *OIDCIdentity) string
+	GetProviderSignupIdentity(tokenString string) (*OIDCIdentity, error)
 	ValidateToken(tokenString string) (*jwt.Token, error)
 	GetClaimsFromToken(tokenString string) (map[string]interface{}, error)
 }
 
 type jwtCustomClaims struct {
+	Name      string `json:"name"`
+	SessionID string `json:"sid"`
+	Role      string `json:"role"`
+	jwt.StandardClaims
+}
+
+// AccessTokenTTL is how long an access token is valid before it must be refreshed
+const AccessTokenTTL = 15 * time.Minute
+
+type jwtVerifyEmailCustomClaims struct {
 	Name  string `json:"name"`
-	Admin bool   `json:"admin"`
+	Email string `json:"email"`
 	jwt.StandardClaims
 }
 
-type jwtResetPasswordCustomClaims struct {
-	Name string `json:"name"`
-	Hash string `json:"hash"`
+type jwtTwoFactorCustomClaims struct {
+	Name    string `json:"name"`
+	Purpose string `json:"purpose"`
 	jwt.StandardClaims
 }
 
+// TwoFactorPurpose marks tokens that can only be exchanged through verifyTwoFactor
+const TwoFactorPurpose = "2fa"
+
+type jwtProviderSignupCustomClaims struct {
+	Identity OIDCIdentity `json:"identity"`
+	Purpose  string       `json:"purpose"`
+	jwt.StandardClaims
+}
+
+// ProviderSignupPurpose marks tokens that can only be exchanged through completeProviderSignup
+const ProviderSignupPurpose = "signup"
+
 type jwtService struct {
 	secretKey string
 	issuer    string
@@ -41,12 +66,13 @@ func getSecretKey() string {
 	return secret
 }
 
-func (jwtSrv *jwtService) GenerateToken(username string, admin bool) string {
+func (jwtSrv *jwtService) GenerateToken(username, sessionID, role string) string {
 	claims := &jwtCustomClaims{
 		username,
-		admin,
+		sessionID,
+		role,
 		jwt.StandardClaims{
-			ExpiresAt: time.Now().Add(time.Hour * 72).Unix(),
+			ExpiresAt: time.Now().Add(AccessTokenTTL).Unix(),
 			Issuer:    jwtSrv.issuer,
 			IssuedAt:  time.Now().Unix(),
 		},
@@ -79,10 +105,10 @@ func (jwtSrv *jwtService) GetClaimsFromToken(tokenString string) (map[string]int
 	return result.Claims.(jwt.MapClaims), nil
 }
 
-func (jwtSrv *jwtService) GenerateResetPasswordToken(name, hash string) string {
-	claims := &jwtResetPasswordCustomClaims{
-		Name: name,
-		Hash: hash,
+func (jwtSrv *jwtService) GenerateVerifyEmailToken(name, email string) string {
+	claims := &jwtVerifyEmailCustomClaims{
+		Name:  name,
+		Email: email,
 		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: time.Now().Add(time.Hour * 24).Unix(),
 			Issuer:    jwtSrv.issuer,
@@ -97,6 +123,56 @@ func (jwtSrv *jwtService) GenerateResetPasswordToken(name, hash string) string {
 	return t
 }
 
+func (jwtSrv *jwtService) GenerateTwoFactorToken(name string) string {
+	claims := &jwtTwoFactorCustomClaims{
+		Name:    name,
+		Purpose: TwoFactorPurpose,
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: time.Now().Add(time.Minute * 5).Unix(),
+			Issuer:    jwtSrv.issuer,
+			IssuedAt:  time.Now().Unix(),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	t, err := token.SignedString([]byte(jwtSrv.secretKey))
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func (jwtSrv *jwtService) GenerateProviderSignupToken(identity *OIDCIdentity) string {
+	claims := &jwtProviderSignupCustomClaims{
+		Identity: *identity,
+		Purpose:  ProviderSignupPurpose,
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: time.Now().Add(time.Minute * 15).Unix(),
+			Issuer:    jwtSrv.issuer,
+			IssuedAt:  time.Now().Unix(),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	t, err := token.SignedString([]byte(jwtSrv.secretKey))
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func (jwtSrv *jwtService) GetProviderSignupIdentity(tokenString string) (*OIDCIdentity, error) {
+	var claims jwtProviderSignupCustomClaims
+	token, err := jwt.ParseWithClaims(tokenString, &claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("Unexpected signing method %v", token.Header["alg"])
+		}
+		return []byte(jwtSrv.secretKey), nil
+	})
+	if err != nil || !token.Valid || claims.Purpose != ProviderSignupPurpose {
+		return nil, errors.New("Invalid or expired token")
+	}
+	return &claims.Identity, nil
+}
+
 // NewJWTService function
 func NewJWTService() JWTService {
 	return &jwtService{
@@ -1,40 +1,301 @@
 package service
 
 import (
+	"bytes"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
 	"net/smtp"
+	"net/textproto"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
+// Mail struct
+type Mail struct {
+	To       string            `json:"to"`
+	Locale   string            `json:"locale"`
+	Template string            `json:"template"`
+	Data     map[string]string `json:"data"`
+}
+
 // MailerService interface
 type MailerService interface {
-	SendMail(recipient, message string) error
+	Send(mail *Mail) error
+}
+
+// MailTransport interface
+type MailTransport interface {
+	Send(from string, to []string, message []byte) error
 }
 
 type mailerService struct {
-	from     string
+	from      string
+	transport MailTransport
+}
+
+// PermanentMailError marks a failure that retrying the same mail won't fix
+type PermanentMailError struct {
+	Err error
+}
+
+func (e *PermanentMailError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap function
+func (e *PermanentMailError) Unwrap() error {
+	return e.Err
+}
+
+// IsPermanentMailError reports whether err is a PermanentMailError or a 5xx
+// reply from the SMTP server
+func IsPermanentMailError(err error) bool {
+	var permanent *PermanentMailError
+	if errors.As(err, &permanent) {
+		return true
+	}
+	var reply *textproto.Error
+	return errors.As(err, &reply) && reply.Code >= 500
+}
+
+func (s *mailerService) Send(mail *Mail) error {
+	subject, text, html, err := RenderMail(mail)
+	if err != nil {
+		return &PermanentMailError{err}
+	}
+	message, err := buildMessage(s.from, mail.To, subject, text, html)
+	if err != nil {
+		return &PermanentMailError{err}
+	}
+	return s.transport.Send(s.from, []string{mail.To}, message)
+}
+
+func buildMessage(from, to, subject, text, html string) ([]byte, error) {
+	if strings.ContainsAny(from+to, "\r\n") {
+		return nil, errors.New("Invalid mail address")
+	}
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for _, part := range []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=UTF-8", text},
+		{"text/html; charset=UTF-8", html},
+	} {
+		w, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(part.content)); err != nil {
+			return nil, err
+		}
+		qp.Close()
+	}
+	writer.Close()
+
+	var message bytes.Buffer
+	message.WriteString("From: " + from + "\r\n")
+	message.WriteString("To: " + to + "\r\n")
+	message.WriteString("Subject: " + mime.QEncoding.Encode("UTF-8", subject) + "\r\n")
+	message.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString("Content-Type: multipart/alternative; boundary=" + writer.Boundary() + "\r\n\r\n")
+	message.Write(body.Bytes())
+	return message.Bytes(), nil
+}
+
+// SMTP TLS modes
+const (
+	SMTPStartTLS = "starttls"
+	SMTPTLS      = "tls"
+	SMTPNoTLS    = "none"
+)
+
+// SMTPTimeout bounds connecting to the SMTP server and, from there, the whole
+// delivery of a message, so a stalled server can't hold up the mail worker
+const SMTPTimeout = 30 * time.Second
+
+type smtpTransport struct {
+	host     string
+	port     int
+	username string
 	password string
+	tlsMode  string
+	timeout  time.Duration
+}
+
+func (t *smtpTransport) Send(from string, to []string, message []byte) error {
+	address := net.JoinHostPort(t.host, strconv.Itoa(t.port))
+	dialer := &net.Dialer{Timeout: t.timeout}
+	var conn net.Conn
+	var err error
+	if t.tlsMode == SMTPTLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", address, &tls.Config{ServerName: t.host})
+	} else {
+		conn, err = dialer.Dial("tcp", address)
+	}
+	if err != nil {
+		return err
+	}
+	conn.SetDeadline(time.Now().Add(t.timeout))
+	client, err := smtp.NewClient(conn, t.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+	if t.tlsMode == SMTPStartTLS {
+		if err := client.StartTLS(&tls.Config{ServerName: t.host}); err != nil {
+			return err
+		}
+	}
+	if t.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", t.username, t.password, t.host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+	for _, recipient := range to {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(message); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
 }
 
-func (s *mailerService) SendMail(recipient, message string) error {
-	msg := "From: " + s.from + "\n" +
-		"To: " + recipient + "\n" +
-		"Subject: Password recovery for " + recipient + "\n\n" +
-		message
-	err := smtp.SendMail("smtp.gmail.com:587",
-		smtp.PlainAuth("", s.from, s.password, "smtp.gmail.com"),
-		s.from,
-		[]string{recipient},
-		[]byte(msg),
-	)
-	return err
+type fileTransport struct {
+	dir string
 }
 
-// NewMailerService function
-func NewMailerService() MailerService {
-	from := os.Getenv("MAILER_FROM")
-	password := os.Getenv("MAILER_PASSWORD")
+func (t *fileTransport) Send(from string, to []string, message []byte) error {
+	if err := os.MkdirAll(t.dir, 0755); err != nil {
+		return err
+	}
+	suffix := make([]byte, 4)
+	rand.Read(suffix)
+	name := strconv.FormatInt(time.Now().UnixNano(), 10) + "-" + hex.EncodeToString(suffix) + ".eml"
+	return ioutil.WriteFile(filepath.Join(t.dir, name), message, 0644)
+}
+
+// SentMail struct
+type SentMail struct {
+	From    string
+	To      []string
+	Message []byte
+}
+
+// MemoryTransport keeps every message it is asked to send, for tests
+type MemoryTransport struct {
+	mutex    sync.Mutex
+	messages []SentMail
+}
+
+// Send function
+func (t *MemoryTransport) Send(from string, to []string, message []byte) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.messages = append(t.messages, SentMail{From: from, To: to, Message: message})
+	return nil
+}
+
+// Messages function
+func (t *MemoryTransport) Messages() []SentMail {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return append([]SentMail(nil), t.messages...)
+}
+
+// NewMemoryTransport function
+func NewMemoryTransport() *MemoryTransport {
+	return &MemoryTransport{}
+}
+
+func newMailTransport() (MailTransport, error) {
+	switch os.Getenv("MAILER_TRANSPORT") {
+	case "", "smtp":
+		host := os.Getenv("SMTP_HOST")
+		if host == "" {
+			host = "smtp.gmail.com"
+		}
+		port := 587
+		if p := os.Getenv("SMTP_PORT"); p != "" {
+			var err error
+			port, err = strconv.Atoi(p)
+			if err != nil {
+				return nil, errors.New("Invalid SMTP_PORT")
+			}
+		}
+		tlsMode := os.Getenv("SMTP_TLS")
+		if tlsMode == "" {
+			tlsMode = SMTPStartTLS
+		}
+		if tlsMode != SMTPStartTLS && tlsMode != SMTPTLS && tlsMode != SMTPNoTLS {
+			return nil, errors.New("Invalid SMTP_TLS, expected starttls, tls or none")
+		}
+		username := os.Getenv("MAILER_USERNAME")
+		if username == "" {
+			username = os.Getenv("MAILER_FROM")
+		}
+		return &smtpTransport{
+			host:     host,
+			port:     port,
+			username: username,
+			password: os.Getenv("MAILER_PASSWORD"),
+			tlsMode:  tlsMode,
+			timeout:  SMTPTimeout,
+		}, nil
+	case "file":
+		dir := os.Getenv("MAILER_DIR")
+		if dir == "" {
+			dir = "mail"
+		}
+		return &fileTransport{dir}, nil
+	}
+	return nil, errors.New("Unknown MAILER_TRANSPORT '" + os.Getenv("MAILER_TRANSPORT") + "'")
+}
+
+// NewMailerServiceWithTransport function
+func NewMailerServiceWithTransport(from string, transport MailTransport) MailerService {
 	return &mailerService{
 		from,
-		password,
+		transport,
+	}
+}
+
+// NewMailerService function
+func NewMailerService() (MailerService, error) {
+	from := os.Getenv("MAILER_FROM")
+	transport, err := newMailTransport()
+	if err != nil {
+		return nil, err
 	}
+	return NewMailerServiceWithTransport(from, transport), nil
 }
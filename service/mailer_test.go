@@ -0,0 +1,294 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// setenv sets an environment variable for the rest of the test
+func setenv(t *testing.T, key, value string) {
+	old, ok := os.LookupEnv(key)
+	os.Setenv(key, value)
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, old)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+func testMailData() map[string]string {
+	return map[string]string{
+		"Name":     "Ana <b>",
+		"Email":    "ana@example.com",
+		"NewEmail": "ana@example.org",
+		"Link":     "https://cancanvas.example.com/resetpassword?token=" + strings.Repeat("a1b2", 20) + "&x=1",
+	}
+}
+
+func TestMailTemplatesComplete(t *testing.T) {
+	for locale, templates := range mailTemplates {
+		for name := range mailTemplates[DefaultLocale] {
+			if _, ok := templates[name]; !ok {
+				t.Errorf("locale %s has no %s template", locale, name)
+			}
+		}
+	}
+}
+
+func TestRenderMail(t *testing.T) {
+	data := testMailData()
+	for locale, templates := range mailTemplates {
+		for name := range templates {
+			subject, text, html, err := RenderMail(&Mail{Locale: locale, Template: name, Data: data})
+			if err != nil {
+				t.Errorf("%s/%s: %v", locale, name, err)
+				continue
+			}
+			for part, content := range map[string]string{"subject": subject, "text": text, "html": html} {
+				if content == "" || strings.Contains(content, "<no value>") {
+					t.Errorf("%s/%s: %s = %q", locale, name, part, content)
+				}
+			}
+			if !strings.Contains(text, data["Link"]) || !strings.Contains(text, data["Name"]) {
+				t.Errorf("%s/%s: text is missing its data: %q", locale, name, text)
+			}
+			if !strings.Contains(html, `href="`+strings.Replace(data["Link"], "&", "&amp;", -1)+`"`) {
+				t.Errorf("%s/%s: html is missing the link: %q", locale, name, html)
+			}
+			if strings.Contains(html, "<b>") || !strings.Contains(html, "Ana &lt;b&gt;") {
+				t.Errorf("%s/%s: html doesn't escape its data: %q", locale, name, html)
+			}
+		}
+	}
+}
+
+func TestRenderMailLocale(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{"pt", "pt"},
+		{"pt-BR", "pt"},
+		{"pt_br", "pt"},
+		{" EN ", "en"},
+		{"fr", DefaultLocale},
+		{"", DefaultLocale},
+	}
+	for _, test := range tests {
+		if got := NormalizeLocale(test.locale); got != test.want {
+			t.Errorf("NormalizeLocale(%q) = %q, want %q", test.locale, got, test.want)
+		}
+		subject, _, _, err := RenderMail(&Mail{Locale: test.locale, Template: MailVerifyEmail})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := mailTemplates[test.want][MailVerifyEmail].subject; subject != want {
+			t.Errorf("%q: subject = %q, want %q", test.locale, subject, want)
+		}
+	}
+}
+
+func TestRenderMailUnknownTemplate(t *testing.T) {
+	if _, _, _, err := RenderMail(&Mail{Template: "unknown"}); err == nil {
+		t.Error("unknown template was rendered")
+	}
+}
+
+func TestMailerSend(t *testing.T) {
+	transport := NewMemoryTransport()
+	mailer := NewMailerServiceWithTransport("noreply@example.com", transport)
+	m := &Mail{To: "ana@example.com", Locale: "pt", Template: MailPasswordReset, Data: testMailData()}
+	if err := mailer.Send(m); err != nil {
+		t.Fatal(err)
+	}
+	sent := transport.Messages()
+	if len(sent) != 1 {
+		t.Fatalf("%d messages were sent, want 1", len(sent))
+	}
+	if sent[0].From != "noreply@example.com" || len(sent[0].To) != 1 || sent[0].To[0] != m.To {
+		t.Errorf("envelope = %s -> %v", sent[0].From, sent[0].To)
+	}
+	subject, text, html, _ := RenderMail(m)
+
+	message, err := mail.ReadMessage(strings.NewReader(string(sent[0].Message)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := message.Header.Get("From"); got != "noreply@example.com" {
+		t.Errorf("From = %q", got)
+	}
+	if got := message.Header.Get("To"); got != m.To {
+		t.Errorf("To = %q", got)
+	}
+	if got, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject")); err != nil || got != subject {
+		t.Errorf("Subject = %q, want %q", got, subject)
+	}
+	if _, err := message.Header.Date(); err != nil {
+		t.Errorf("Date: %v", err)
+	}
+	mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("Content-Type = %q", message.Header.Get("Content-Type"))
+	}
+
+	// plain text comes first, so clients that can show HTML prefer it
+	want := []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=UTF-8", text},
+		{"text/html; charset=UTF-8", html},
+	}
+	reader := multipart.NewReader(message.Body, params["boundary"])
+	for i := 0; ; i++ {
+		part, err := reader.NextPart()
+		if err != nil {
+			if i != len(want) {
+				t.Errorf("message has %d parts, want %d: %v", i, len(want), err)
+			}
+			break
+		}
+		if i >= len(want) {
+			t.Fatal("message has too many parts")
+		}
+		if got := part.Header.Get("Content-Type"); got != want[i].contentType {
+			t.Errorf("part %d: Content-Type = %q, want %q", i, got, want[i].contentType)
+		}
+		// the reader decodes quoted-printable parts itself, line breaks are
+		// sent as CRLF
+		content, err := ioutil.ReadAll(part)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Replace(string(content), "\r\n", "\n", -1) != want[i].content {
+			t.Errorf("part %d = %q, want %q", i, content, want[i].content)
+		}
+	}
+	for _, line := range strings.Split(string(sent[0].Message), "\r\n") {
+		if len(line) > 998 {
+			t.Errorf("line is longer than SMTP allows: %d", len(line))
+		}
+	}
+}
+
+func TestMailerSendPermanentErrors(t *testing.T) {
+	mailer := NewMailerServiceWithTransport("noreply@example.com", NewMemoryTransport())
+	tests := map[string]*Mail{
+		"unknown template": {To: "ana@example.com", Template: "unknown"},
+		"header injection": {To: "ana@example.com\r\nBcc: eve@example.com", Template: MailVerifyEmail},
+	}
+	for name, m := range tests {
+		if err := mailer.Send(m); !IsPermanentMailError(err) {
+			t.Errorf("%s: err = %v, want a permanent error", name, err)
+		}
+	}
+}
+
+func TestIsPermanentMailError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{&PermanentMailError{errors.New("bad template")}, true},
+		{fmt.Errorf("send: %w", &PermanentMailError{errors.New("bad template")}), true},
+		{&textproto.Error{Code: 550, Msg: "No such user"}, true},
+		{&textproto.Error{Code: 451, Msg: "Try again later"}, false},
+		{errors.New("connection refused"), false},
+	}
+	for _, test := range tests {
+		if got := IsPermanentMailError(test.err); got != test.want {
+			t.Errorf("IsPermanentMailError(%v) = %v, want %v", test.err, got, test.want)
+		}
+	}
+}
+
+func TestFileTransport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mail")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	mailer := NewMailerServiceWithTransport("noreply@example.com", &fileTransport{filepath.Join(dir, "out")})
+	if err := mailer.Send(&Mail{To: "ana@example.com", Template: MailVerifyEmail}); err != nil {
+		t.Fatal(err)
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "out", "*.eml"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("files = %v, %v", files, err)
+	}
+}
+
+// TestSMTPTransportTimeout checks that a server that accepts connections but
+// never answers doesn't block delivery
+func TestSMTPTransportTimeout(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+	address := listener.Addr().(*net.TCPAddr)
+	for _, mode := range []string{SMTPNoTLS, SMTPStartTLS, SMTPTLS} {
+		transport := &smtpTransport{host: "127.0.0.1", port: address.Port, tlsMode: mode, timeout: 200 * time.Millisecond}
+		done := make(chan error, 1)
+		go func() {
+			done <- transport.Send("noreply@example.com", []string{"ana@example.com"}, []byte("Subject: test\r\n\r\ntest\r\n"))
+		}()
+		select {
+		case err := <-done:
+			if err == nil {
+				t.Errorf("%s: send to a silent server succeeded", mode)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s: send didn't time out", mode)
+		}
+	}
+}
+
+func TestNewMailerService(t *testing.T) {
+	tests := []struct {
+		env   map[string]string
+		valid bool
+	}{
+		{map[string]string{"MAILER_TRANSPORT": "smtp", "SMTP_PORT": "465", "SMTP_TLS": SMTPTLS}, true},
+		{map[string]string{"MAILER_TRANSPORT": "file"}, true},
+		{map[string]string{"MAILER_TRANSPORT": "pigeon"}, false},
+		{map[string]string{"MAILER_TRANSPORT": "smtp", "SMTP_PORT": "smtp"}, false},
+		{map[string]string{"MAILER_TRANSPORT": "smtp", "SMTP_TLS": "ssl"}, false},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.env), func(t *testing.T) {
+			for _, key := range []string{"MAILER_TRANSPORT", "SMTP_PORT", "SMTP_TLS"} {
+				setenv(t, key, test.env[key])
+			}
+			mailer, err := NewMailerService()
+			if test.valid && (err != nil || mailer == nil) {
+				t.Errorf("err = %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Error("invalid configuration was accepted")
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package service
+
+import (
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// OIDCService interface
+type OIDCService interface {
+	Exchange(provider, code, nonce string) (*OIDCIdentity, error)
+}
+
+// OIDCIdentity struct
+type OIDCIdentity struct {
+	Provider      string `json:"provider"`
+	Subject       string `json:"sub"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+	Picture       string `json:"picture"`
+}
+
+type oidcDiscovery struct {
+	Issuer        string `json:"issuer"`
+	TokenEndpoint string `json:"token_endpoint"`
+	JWKSURI       string `json:"jwks_uri"`
+}
+
+type oidcProvider struct {
+	name         string
+	issuer       string
+	clientID     string
+	clientSecret string
+	redirectURL  string
+
+	mutex     sync.Mutex
+	discovery *oidcDiscovery
+	keys      map[string]*rsa.PublicKey
+}
+
+type oidcService struct {
+	providers  map[string]*oidcProvider
+	httpClient *http.Client
+}
+
+// Exchange redeems an authorization code. nonce is the value the client sent
+// along with the authorization request, which the ID token must carry so
+// that a token issued for another login can't be replayed.
+func (s *oidcService) Exchange(provider, code, nonce string) (*OIDCIdentity, error) {
+	p, ok := s.providers[strings.ToLower(provider)]
+	if !ok {
+		return nil, errors.New("Unknown provider '" + provider + "'")
+	}
+	if nonce == "" {
+		return nil, errors.New("Missing nonce")
+	}
+	discovery, err := s.discover(p)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.redirectURL)
+	form.Set("client_id", p.clientID)
+	form.Set("client_secret", p.clientSecret)
+	response, err := s.httpClient.PostForm(discovery.TokenEndpoint, form)
+	if err != nil {
+		return nil, errors.New("Could not reach provider")
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.New("Invalid authorization code")
+	}
+	var tokens struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&tokens); err != nil || tokens.IDToken == "" {
+		return nil, errors.New("Provider did not return an ID token")
+	}
+	return s.verifyIDToken(p, discovery, tokens.IDToken, nonce)
+}
+
+func (s *oidcService) verifyIDToken(p *oidcProvider, discovery *oidcDiscovery, idToken, nonce string) (*OIDCIdentity, error) {
+	token, err := jwt.Parse(idToken, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("Unexpected signing method %v", token.Header["alg"])
+		}
+		kid, _ := token.Header["kid"].(string)
+		return s.key(p, discovery, kid)
+	})
+	if err != nil || !token.Valid {
+		return nil, errors.New("Invalid ID token")
+	}
+	claims := token.Claims.(jwt.MapClaims)
+	// jwt.Parse only checks exp when the token has one
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errors.New("Invalid ID token")
+	}
+	if !claims.VerifyIssuer(discovery.Issuer, true) {
+		return nil, errors.New("Invalid ID token issuer")
+	}
+	if !audienceContains(claims["aud"], p.clientID) {
+		return nil, errors.New("Invalid ID token audience")
+	}
+	if n, _ := claims["nonce"].(string); subtle.ConstantTimeCompare([]byte(n), []byte(nonce)) != 1 {
+		return nil, errors.New("Invalid ID token nonce")
+	}
+	identity := &OIDCIdentity{Provider: p.name}
+	identity.Subject, _ = claims["sub"].(string)
+	identity.Email, _ = claims["email"].(string)
+	identity.Name, _ = claims["name"].(string)
+	identity.Picture, _ = claims["picture"].(string)
+	switch verified := claims["email_verified"].(type) {
+	case bool:
+		identity.EmailVerified = verified
+	case string:
+		identity.EmailVerified = verified == "true"
+	}
+	if identity.Subject == "" {
+		return nil, errors.New("Invalid ID token subject")
+	}
+	return identity, nil
+}
+
+func audienceContains(aud interface{}, clientID string) bool {
+	switch a := aud.(type) {
+	case string:
+		return a == clientID
+	case []interface{}:
+		for _, v := range a {
+			if v == clientID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *oidcService) discover(p *oidcProvider) (*oidcDiscovery, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+	response, err := s.httpClient.Get(strings.TrimSuffix(p.issuer, "/") + "/.well-known/openid-configuration")
+	if err != nil {
+		return nil, errors.New("Could not reach provider")
+	}
+	defer response.Body.Close()
+	var discovery oidcDiscovery
+	if err := json.NewDecoder(response.Body).Decode(&discovery); err != nil {
+		return nil, errors.New("Invalid provider configuration")
+	}
+	if discovery.TokenEndpoint == "" || discovery.JWKSURI == "" {
+		return nil, errors.New("Invalid provider configuration")
+	}
+	// ID tokens are checked against the discovered issuer, so it has to be
+	// the one that was configured
+	if strings.TrimSuffix(discovery.Issuer, "/") != strings.TrimSuffix(p.issuer, "/") {
+		return nil, errors.New("Invalid provider configuration")
+	}
+	p.discovery = &discovery
+	return p.discovery, nil
+}
+
+// key looks up the signing key for kid, fetching the provider's key set again
+// when kid is unknown in case the keys were rotated
+func (s *oidcService) key(p *oidcProvider, discovery *oidcDiscovery, kid string) (*rsa.PublicKey, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	response, err := s.httpClient.Get(discovery.JWKSURI)
+	if err != nil {
+		return nil, errors.New("Could not reach provider")
+	}
+	defer response.Body.Close()
+	var jwks struct {
+		Keys []struct {
+			Kid string `json:"kid"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&jwks); err != nil {
+		return nil, errors.New("Invalid provider keys")
+	}
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			continue
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	p.keys = keys
+	if key, ok := keys[kid]; ok {
+		return key, nil
+	}
+	return nil, errors.New("Unknown signing key")
+}
+
+// NewOIDCService function
+func NewOIDCService() OIDCService {
+	providers := make(map[string]*oidcProvider)
+	for _, name := range strings.Split(os.Getenv("OIDC_PROVIDERS"), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		providers[name] = &oidcProvider{
+			name:         name,
+			issuer:       os.Getenv(prefix + "ISSUER"),
+			clientID:     os.Getenv(prefix + "CLIENT_ID"),
+			clientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			redirectURL:  os.Getenv(prefix + "REDIRECT_URL"),
+		}
+	}
+	return &oidcService{
+		providers:  providers,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
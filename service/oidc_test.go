@@ -0,0 +1,235 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+const (
+	testClientID     = "client"
+	testClientSecret = "secret"
+	testCode         = "code"
+	testNonce        = "nonce"
+)
+
+// fakeIssuer is an OIDC provider that answers the token request for
+// testCode with the ID token built by sign
+type fakeIssuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	kid    string
+	issuer string
+	sign   func(claims jwt.MapClaims) string
+}
+
+func newTestKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	f := &fakeIssuer{key: newTestKey(t), kid: "key-1"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":         f.issuer,
+			"token_endpoint": f.server.URL + "/token",
+			"jwks_uri":       f.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kid": f.kid,
+				"kty": "RSA",
+				"n":   base64.RawURLEncoding.EncodeToString(f.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(f.key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.PostFormValue("code") != testCode ||
+			r.PostFormValue("client_id") != testClientID ||
+			r.PostFormValue("client_secret") != testClientSecret {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"id_token": f.sign(f.claims())})
+	})
+	f.server = httptest.NewServer(mux)
+	t.Cleanup(f.server.Close)
+	f.issuer = f.server.URL
+	f.sign = f.signRS256
+	return f
+}
+
+func (f *fakeIssuer) claims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":            f.server.URL,
+		"aud":            testClientID,
+		"sub":            "subject",
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          testNonce,
+		"email":          "user@example.com",
+		"email_verified": true,
+		"name":           "User",
+	}
+}
+
+func (f *fakeIssuer) signRS256(claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = f.kid
+	signed, err := token.SignedString(f.key)
+	if err != nil {
+		panic(err)
+	}
+	return signed
+}
+
+func (f *fakeIssuer) service() *oidcService {
+	return &oidcService{
+		providers: map[string]*oidcProvider{"fake": {
+			name:         "fake",
+			issuer:       f.server.URL,
+			clientID:     testClientID,
+			clientSecret: testClientSecret,
+		}},
+		httpClient: f.server.Client(),
+	}
+}
+
+func TestOIDCExchange(t *testing.T) {
+	f := newFakeIssuer(t)
+	identity, err := f.service().Exchange("Fake", testCode, testNonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := OIDCIdentity{
+		Provider:      "fake",
+		Subject:       "subject",
+		Email:         "user@example.com",
+		EmailVerified: true,
+		Name:          "User",
+	}
+	if *identity != want {
+		t.Errorf("identity = %+v, want %+v", *identity, want)
+	}
+}
+
+func TestOIDCExchangeRejectsTokens(t *testing.T) {
+	otherKey := newTestKey(t)
+	tests := []struct {
+		name  string
+		claim func(claims jwt.MapClaims)
+		sign  func(f *fakeIssuer, claims jwt.MapClaims) string
+	}{
+		{name: "wrong issuer", claim: func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" }},
+		{name: "missing issuer", claim: func(c jwt.MapClaims) { delete(c, "iss") }},
+		{name: "wrong audience", claim: func(c jwt.MapClaims) { c["aud"] = "other" }},
+		{name: "audience list without client", claim: func(c jwt.MapClaims) { c["aud"] = []string{"other", "another"} }},
+		{name: "expired", claim: func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() }},
+		{name: "missing expiry", claim: func(c jwt.MapClaims) { delete(c, "exp") }},
+		{name: "wrong nonce", claim: func(c jwt.MapClaims) { c["nonce"] = "replayed" }},
+		{name: "missing nonce", claim: func(c jwt.MapClaims) { delete(c, "nonce") }},
+		{name: "missing subject", claim: func(c jwt.MapClaims) { delete(c, "sub") }},
+		{name: "unknown kid", sign: func(f *fakeIssuer, c jwt.MapClaims) string {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, c)
+			token.Header["kid"] = "key-2"
+			signed, _ := token.SignedString(f.key)
+			return signed
+		}},
+		{name: "signed by another key", sign: func(f *fakeIssuer, c jwt.MapClaims) string {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, c)
+			token.Header["kid"] = f.kid
+			signed, _ := token.SignedString(otherKey)
+			return signed
+		}},
+		{name: "HMAC signed", sign: func(f *fakeIssuer, c jwt.MapClaims) string {
+			token := jwt.NewWithClaims(jwt.SigningMethodHS256, c)
+			token.Header["kid"] = f.kid
+			signed, _ := token.SignedString([]byte(testClientSecret))
+			return signed
+		}},
+		{name: "unsigned", sign: func(f *fakeIssuer, c jwt.MapClaims) string {
+			signed, _ := jwt.NewWithClaims(jwt.SigningMethodNone, c).SignedString(jwt.UnsafeAllowNoneSignatureType)
+			return signed
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f := newFakeIssuer(t)
+			sign := f.signRS256
+			if test.sign != nil {
+				sign = func(c jwt.MapClaims) string { return test.sign(f, c) }
+			}
+			f.sign = func(c jwt.MapClaims) string {
+				if test.claim != nil {
+					test.claim(c)
+				}
+				return sign(c)
+			}
+			if identity, err := f.service().Exchange("fake", testCode, testNonce); err == nil {
+				t.Errorf("accepted %+v", identity)
+			}
+		})
+	}
+}
+
+func TestOIDCExchangeAudienceList(t *testing.T) {
+	f := newFakeIssuer(t)
+	f.sign = func(c jwt.MapClaims) string {
+		c["aud"] = []string{"other", testClientID}
+		return f.signRS256(c)
+	}
+	if _, err := f.service().Exchange("fake", testCode, testNonce); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOIDCExchangeRejectsRequests(t *testing.T) {
+	f := newFakeIssuer(t)
+	s := f.service()
+	if _, err := s.Exchange("other", testCode, testNonce); err == nil {
+		t.Error("unknown provider was accepted")
+	}
+	if _, err := s.Exchange("fake", "wrong", testNonce); err == nil {
+		t.Error("invalid code was accepted")
+	}
+	if _, err := s.Exchange("fake", testCode, ""); err == nil {
+		t.Error("missing nonce was accepted")
+	}
+}
+
+func TestOIDCDiscoveryIssuerMismatch(t *testing.T) {
+	f := newFakeIssuer(t)
+	f.issuer = "https://evil.example.com"
+	if _, err := f.service().Exchange("fake", testCode, testNonce); err == nil {
+		t.Error("discovery of another issuer was accepted")
+	}
+}
+
+func TestOIDCKeyRotation(t *testing.T) {
+	f := newFakeIssuer(t)
+	s := f.service()
+	if _, err := s.Exchange("fake", testCode, testNonce); err != nil {
+		t.Fatal(err)
+	}
+	f.key = newTestKey(t)
+	f.kid = "key-2"
+	if _, err := s.Exchange("fake", testCode, testNonce); err != nil {
+		t.Fatalf("rotated key wasn't fetched: %v", err)
+	}
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+// Mail templates
+const (
+	MailPasswordReset = "password_reset"
+	MailVerifyEmail   = "verify_email"
+	MailEmailChanged  = "email_changed"
+)
+
+// DefaultLocale is used when a user has no locale or it has no translation
+const DefaultLocale = "en"
+
+type mailTemplate struct {
+	subject string
+	text    string
+	html    string
+}
+
+// mailTemplates holds every message type per locale. Templates receive the
+// Mail's Data map, so {{.Link}} renders Data["Link"].
+var mailTemplates = map[string]map[string]mailTemplate{
+	"en": {
+		MailPasswordReset: {
+			subject: "Password recovery for {{.Email}}",
+			text: "Hi {{.Name}},\n\n" +
+				"A password reset was requested for the Cancanvas account associated with {{.Email}}. " +
+				"Open the link below to choose a new password:\n" +
+				"{{.Link}}\n\n" +
+				"The link expires in one hour. If you didn't request this change, please ignore this email.\n\n" +
+				"Cancanvas Team\n",
+			html: `<p>Hi {{.Name}},</p>` +
+				`<p>A password reset was requested for the Cancanvas account associated with {{.Email}}.</p>` +
+				`<p><a href="{{.Link}}">Choose a new password</a></p>` +
+				`<p>The link expires in one hour. If you didn't request this change, please ignore this email.</p>` +
+				`<p>Cancanvas Team</p>`,
+		},
+		MailVerifyEmail: {
+			subject: "Verify your email address",
+			text: "Hi {{.Name}},\n\n" +
+				"Please confirm that {{.Email}} is the email address of your Cancanvas account by opening the link below:\n" +
+				"{{.Link}}\n\n" +
+				"If you didn't create an account, please ignore this email.\n\n" +
+				"Cancanvas Team\n",
+			html: `<p>Hi {{.Name}},</p>` +
+				`<p>Please confirm that {{.Email}} is the email address of your Cancanvas account.</p>` +
+				`<p><a href="{{.Link}}">Verify my email address</a></p>` +
+				`<p>If you didn't create an account, please ignore this email.</p>` +
+				`<p>Cancanvas Team</p>`,
+		},
+		MailEmailChanged: {
+			subject: "The email address of your account was changed",
+			text: "Hi {{.Name}},\n\n" +
+				"The email address of your Cancanvas account was changed from {{.Email}} to {{.NewEmail}}.\n\n" +
+				"If you didn't make this change, open the link below to choose a new password, " +
+				"which signs everyone out of your account:\n" +
+				"{{.Link}}\n\n" +
+				"The link expires in one hour.\n\n" +
+				"Cancanvas Team\n",
+			html: `<p>Hi {{.Name}},</p>` +
+				`<p>The email address of your Cancanvas account was changed from {{.Email}} to {{.NewEmail}}.</p>` +
+				`<p>If you didn't make this change, choose a new password, which signs everyone out of your account.</p>` +
+				`<p><a href="{{.Link}}">Choose a new password</a></p>` +
+				`<p>The link expires in one hour.</p>` +
+				`<p>Cancanvas Team</p>`,
+		},
+	},
+	"pt": {
+		MailPasswordReset: {
+			subject: "Recuperação de senha para {{.Email}}",
+			text: "Olá {{.Name}},\n\n" +
+				"Foi solicitada a redefinição da senha da conta Cancanvas associada a {{.Email}}. " +
+				"Abra o link abaixo para escolher uma nova senha:\n" +
+				"{{.Link}}\n\n" +
+				"O link expira em uma hora. Se você não solicitou essa alteração, ignore este email.\n\n" +
+				"Equipe Cancanvas\n",
+			html: `<p>Olá {{.Name}},</p>` +
+				`<p>Foi solicitada a redefinição da senha da conta Cancanvas associada a {{.Email}}.</p>` +
+				`<p><a href="{{.Link}}">Escolher uma nova senha</a></p>` +
+				`<p>O link expira em uma hora. Se você não solicitou essa alteração, ignore este email.</p>` +
+				`<p>Equipe Cancanvas</p>`,
+		},
+		MailVerifyEmail: {
+			subject: "Confirme seu endereço de email",
+			text: "Olá {{.Name}},\n\n" +
+				"Confirme que {{.Email}} é o endereço de email da sua conta Cancanvas abrindo o link abaixo:\n" +
+				"{{.Link}}\n\n" +
+				"Se você não criou uma conta, ignore este email.\n\n" +
+				"Equipe Cancanvas\n",
+			html: `<p>Olá {{.Name}},</p>` +
+				`<p>Confirme que {{.Email}} é o endereço de email da sua conta Cancanvas.</p>` +
+				`<p><a href="{{.Link}}">Confirmar meu email</a></p>` +
+				`<p>Se você não criou uma conta, ignore este email.</p>` +
+				`<p>Equipe Cancanvas</p>`,
+		},
+		MailEmailChanged: {
+			subject: "O endereço de email da sua conta foi alterado",
+			text: "Olá {{.Name}},\n\n" +
+				"O endereço de email da sua conta Cancanvas foi alterado de {{.Email}} para {{.NewEmail}}.\n\n" +
+				"Se você não fez essa alteração, abra o link abaixo para escolher uma nova senha, " +
+				"o que desconecta todos da sua conta:\n" +
+				"{{.Link}}\n\n" +
+				"O link expira em uma hora.\n\n" +
+				"Equipe Cancanvas\n",
+			html: `<p>Olá {{.Name}},</p>` +
+				`<p>O endereço de email da sua conta Cancanvas foi alterado de {{.Email}} para {{.NewEmail}}.</p>` +
+				`<p>Se você não fez essa alteração, escolha uma nova senha, o que desconecta todos da sua conta.</p>` +
+				`<p><a href="{{.Link}}">Escolher uma nova senha</a></p>` +
+				`<p>O link expira em uma hora.</p>` +
+				`<p>Equipe Cancanvas</p>`,
+		},
+	},
+}
+
+type parsedTemplate struct {
+	subject *texttemplate.Template
+	text    *texttemplate.Template
+	html    *htmltemplate.Template
+}
+
+var parsedTemplates = parseMailTemplates()
+
+func parseMailTemplates() map[string]map[string]parsedTemplate {
+	parsed := make(map[string]map[string]parsedTemplate)
+	for locale, templates := range mailTemplates {
+		parsed[locale] = make(map[string]parsedTemplate)
+		for name, t := range templates {
+			id := locale + "/" + name
+			parsed[locale][name] = parsedTemplate{
+				subject: texttemplate.Must(texttemplate.New(id + "/subject").Option("missingkey=zero").Parse(t.subject)),
+				text:    texttemplate.Must(texttemplate.New(id + "/text").Option("missingkey=zero").Parse(t.text)),
+				html:    htmltemplate.Must(htmltemplate.New(id + "/html").Option("missingkey=zero").Parse(t.html)),
+			}
+		}
+	}
+	return parsed
+}
+
+// NormalizeLocale maps a locale such as "pt-BR" to the closest one with
+// translations, falling back to DefaultLocale
+func NormalizeLocale(locale string) string {
+	locale = strings.ToLower(strings.Replace(strings.TrimSpace(locale), "_", "-", -1))
+	if _, ok := mailTemplates[locale]; ok {
+		return locale
+	}
+	if i := strings.Index(locale, "-"); i > 0 {
+		if _, ok := mailTemplates[locale[:i]]; ok {
+			return locale[:i]
+		}
+	}
+	return DefaultLocale
+}
+
+// IsSupportedLocale function
+func IsSupportedLocale(locale string) bool {
+	locale = strings.ToLower(strings.Replace(strings.TrimSpace(locale), "_", "-", -1))
+	if i := strings.Index(locale, "-"); i > 0 {
+		locale = locale[:i]
+	}
+	_, ok := mailTemplates[locale]
+	return ok
+}
+
+// RenderMail renders the subject, plain text and HTML bodies of mail
+func RenderMail(mail *Mail) (subject, text, html string, err error) {
+	t, ok := parsedTemplates[NormalizeLocale(mail.Locale)][mail.Template]
+	if !ok {
+		t, ok = parsedTemplates[DefaultLocale][mail.Template]
+	}
+	if !ok {
+		return "", "", "", errors.New("Unknown mail template '" + mail.Template + "'")
+	}
+	data := mail.Data
+	if data == nil {
+		data = map[string]string{}
+	}
+	var b bytes.Buffer
+	if err := t.subject.Execute(&b, data); err != nil {
+		return "", "", "", err
+	}
+	subject = b.String()
+	b.Reset()
+	if err := t.text.Execute(&b, data); err != nil {
+		return "", "", "", err
+	}
+	text = b.String()
+	b.Reset()
+	if err := t.html.Execute(&b, data); err != nil {
+		return "", "", "", err
+	}
+	return subject, text, b.String(), nil
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTPService interface
+type TOTPService interface {
+	GenerateSecret() (string, error)
+	ProvisioningURI(account, secret string) string
+	Validate(secret, code string, lastCounter int64) (int64, bool)
+}
+
+type totpService struct {
+	issuer string
+	digits int
+	period int64
+	skew   int64
+}
+
+var base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func (s *totpService) GenerateSecret() (string, error) {
+	secret := make([]byte, 20)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return base32NoPadding.EncodeToString(secret), nil
+}
+
+func (s *totpService) ProvisioningURI(account, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", s.issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprintf("%d", s.digits))
+	query.Set("period", fmt.Sprintf("%d", s.period))
+	label := url.PathEscape(s.issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// Validate checks code against the time steps around now and returns the
+// matched counter. Counters up to lastCounter are rejected so that a code
+// can't be replayed.
+func (s *totpService) Validate(secret, code string, lastCounter int64) (int64, bool) {
+	key, err := base32NoPadding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != s.digits {
+		return 0, false
+	}
+	counter := time.Now().Unix() / s.period
+	for i := -s.skew; i <= s.skew; i++ {
+		c := counter + i
+		if c <= lastCounter {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(s.generate(key, c)), []byte(code)) == 1 {
+			return c, true
+		}
+	}
+	return 0, false
+}
+
+func (s *totpService) generate(key []byte, counter int64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(counter))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0xf
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < s.digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", s.digits, value%mod)
+}
+
+// NewTOTPService function
+func NewTOTPService() TOTPService {
+	return &totpService{
+		issuer: "Cancanvas",
+		digits: 6,
+		period: 30,
+		skew:   1,
+	}
+}
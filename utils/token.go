@@ -6,42 +6,96 @@ import (
 	"fmt"
 
 	"github.com/99designs/gqlgen/handler"
+	"github.com/eaemenkkstudios/cancanvas-backend/repository"
 	"github.com/eaemenkkstudios/cancanvas-backend/service"
 )
 
 var jwtService = service.NewJWTService()
+var sessionRepository = repository.NewSessionRepository()
 
-// GetSenderFromTokenHTTP function
-func GetSenderFromTokenHTTP(ctx context.Context) (string, error) {
-	token := ctx.Value("token")
-	if fmt.Sprintf("%v", token) == "<nil>" {
-		return "", errors.New("Unauthorized")
-	}
-	return getSenderFromClaims(fmt.Sprintf("%v", token))
+type authContextKey struct{}
+
+// Auth struct
+type Auth struct {
+	Sender    string
+	SessionID string
+	Role      string
 }
 
-// GetSenderFromTokenSocket function
-func GetSenderFromTokenSocket(ctx context.Context) (string, error) {
-	token := handler.GetInitPayload(ctx).GetString("Authorization")
+// Authenticate function
+func Authenticate(ctx context.Context) (*Auth, error) {
+	if auth := GetAuth(ctx); auth != nil {
+		return auth, nil
+	}
+	token := fmt.Sprintf("%v", ctx.Value("token"))
+	if token == "<nil>" {
+		token = handler.GetInitPayload(ctx).GetString("Authorization")
+	}
 	if token == "" {
-		return "", errors.New("Unauthorized")
+		return nil, errors.New("Unauthorized")
 	}
-	return getSenderFromClaims(token)
+	return getAuthFromClaims(token)
+}
+
+// WithAuth function
+func WithAuth(ctx context.Context, auth *Auth) context.Context {
+	return context.WithValue(ctx, authContextKey{}, auth)
+}
+
+// GetAuth function
+func GetAuth(ctx context.Context) *Auth {
+	auth, _ := ctx.Value(authContextKey{}).(*Auth)
+	return auth
 }
 
-// GetSenderAndHashFromToken function
-func GetSenderAndHashFromToken(token string) (sender string, hash string, err error) {
+// GetSender function
+func GetSender(ctx context.Context) string {
+	if auth := GetAuth(ctx); auth != nil {
+		return auth.Sender
+	}
+	return ""
+}
+
+// GetViewer returns the sender of fields that can be queried anonymously,
+// authenticating them when they sent a token. Invalid tokens are treated as
+// anonymous requests.
+func GetViewer(ctx context.Context) string {
+	if sender := GetSender(ctx); sender != "" {
+		return sender
+	}
+	auth, err := Authenticate(ctx)
+	if err != nil {
+		return ""
+	}
+	return auth.Sender
+}
+
+// GetSenderAndEmailFromToken function
+func GetSenderAndEmailFromToken(token string) (sender string, email string, err error) {
 	claims, err := jwtService.GetClaimsFromToken(token)
 	if err != nil {
 		return "", "", errors.New("Invalid or expired token")
 	}
-	return fmt.Sprintf("%v", claims["name"]), fmt.Sprintf("%v", claims["hash"]), nil
+	email, ok := claims["email"].(string)
+	if !ok {
+		return "", "", errors.New("Invalid or expired token")
+	}
+	return fmt.Sprintf("%v", claims["name"]), email, nil
 }
 
-func getSenderFromClaims(token string) (string, error) {
+func getAuthFromClaims(token string) (*Auth, error) {
 	claims, err := jwtService.GetClaimsFromToken(token)
 	if err != nil {
-		return "", errors.New("Unauthorized")
+		return nil, errors.New("Unauthorized")
+	}
+	sessionID, ok := claims["sid"].(string)
+	if !ok || !sessionRepository.IsSessionActive(sessionID) {
+		return nil, errors.New("Unauthorized")
 	}
-	return fmt.Sprintf("%v", claims["name"]), nil
+	role, _ := claims["role"].(string)
+	return &Auth{
+		Sender:    fmt.Sprintf("%v", claims["name"]),
+		SessionID: sessionID,
+		Role:      role,
+	}, nil
 }